@@ -0,0 +1,283 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/devonberta/terraform-provider-synadia-cloud/provider"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// generator accumulates import blocks and resource configuration while the
+// control plane is walked.
+type generator struct {
+	client    *provider.Client
+	imports   *hclwrite.File
+	resources *hclwrite.File
+	labels    map[string]bool
+	count     int
+}
+
+func newGenerator(client *provider.Client) *generator {
+	return &generator{
+		client:    client,
+		imports:   hclwrite.NewEmptyFile(),
+		resources: hclwrite.NewEmptyFile(),
+		labels:    map[string]bool{},
+	}
+}
+
+func (g *generator) walkTeam(ctx context.Context, teamID string) error {
+	users, err := g.client.ListTeamAppUsers(ctx, teamID)
+	if err != nil {
+		return fmt.Errorf("listing app users of team %s: %w", teamID, err)
+	}
+	for _, u := range users {
+		body := g.add("synadia_app_user", u.Email, u.ID)
+		body.SetAttributeValue("team_id", cty.StringVal(teamID))
+		body.SetAttributeValue("email", cty.StringVal(u.Email))
+		if u.Name != "" {
+			body.SetAttributeValue("name", cty.StringVal(u.Name))
+		}
+	}
+
+	systems, err := g.client.ListTeamSystems(ctx, teamID)
+	if err != nil {
+		return fmt.Errorf("listing systems of team %s: %w", teamID, err)
+	}
+	for _, s := range systems {
+		if err := g.walkSystem(ctx, s.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *generator) walkSystem(ctx context.Context, systemID string) error {
+	accounts, err := g.client.ListAccounts(ctx, systemID, provider.ListFilter{})
+	if err != nil {
+		return fmt.Errorf("listing accounts of system %s: %w", systemID, err)
+	}
+	for i := range accounts {
+		if err := g.emitAccount(ctx, &accounts[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *generator) walkAccount(ctx context.Context, accountID string) error {
+	account, err := g.client.GetAccount(ctx, accountID)
+	if err != nil {
+		return fmt.Errorf("reading account %s: %w", accountID, err)
+	}
+	return g.emitAccount(ctx, account)
+}
+
+func (g *generator) emitAccount(ctx context.Context, account *provider.Account) error {
+	accountLabel := g.label(account.Name)
+	body := g.addLabeled("synadia_account", accountLabel, account.ID)
+	body.SetAttributeValue("system_id", cty.StringVal(account.SystemID))
+	body.SetAttributeValue("name", cty.StringVal(account.Name))
+	setOptionalString(body, "description", account.Description)
+
+	accountRef := traversal("synadia_account", accountLabel, "id")
+
+	users, err := g.client.ListNatsUsers(ctx, account.ID, provider.ListFilter{})
+	if err != nil {
+		return fmt.Errorf("listing NATS users of account %s: %w", account.ID, err)
+	}
+	for _, u := range users {
+		body := g.add("synadia_nats_user", account.Name+"_"+u.Name, u.ID)
+		body.SetAttributeTraversal("account_id", accountRef)
+		body.SetAttributeValue("name", cty.StringVal(u.Name))
+	}
+
+	streams, err := g.client.ListStreams(ctx, account.ID)
+	if err != nil {
+		return fmt.Errorf("listing streams of account %s: %w", account.ID, err)
+	}
+	for _, s := range streams {
+		cfg := s.Config
+		// Buckets are emitted from their own list below.
+		if provider.IsBucketStream(cfg.Name) {
+			continue
+		}
+
+		streamLabel := g.label(account.Name + "_" + cfg.Name)
+		body := g.addLabeled("synadia_stream", streamLabel, account.ID+"/"+cfg.Name)
+		body.SetAttributeTraversal("account_id", accountRef)
+		body.SetAttributeValue("name", cty.StringVal(cfg.Name))
+		setOptionalString(body, "description", cfg.Description)
+		body.SetAttributeValue("subjects", stringList(cfg.Subjects))
+		body.SetAttributeValue("retention", cty.StringVal(cfg.Retention))
+		body.SetAttributeValue("storage", cty.StringVal(cfg.Storage))
+		body.SetAttributeValue("replicas", cty.NumberIntVal(cfg.Replicas))
+		body.SetAttributeValue("max_msgs", cty.NumberIntVal(cfg.MaxMsgs))
+		body.SetAttributeValue("max_bytes", cty.NumberIntVal(cfg.MaxBytes))
+		body.SetAttributeValue("max_age_seconds", cty.NumberIntVal(cfg.MaxAge/provider.NanosPerSecond))
+		setPlacement(body, cfg.Placement)
+
+		if err := g.emitConsumers(ctx, account, accountRef, streamLabel, cfg.Name); err != nil {
+			return err
+		}
+	}
+
+	kvs, err := g.client.ListKVBuckets(ctx, account.ID)
+	if err != nil {
+		return fmt.Errorf("listing kv buckets of account %s: %w", account.ID, err)
+	}
+	for _, b := range kvs {
+		cfg := b.Config
+		body := g.add("synadia_kv_bucket", account.Name+"_"+cfg.Bucket, account.ID+"/"+cfg.Bucket)
+		body.SetAttributeTraversal("account_id", accountRef)
+		body.SetAttributeValue("bucket", cty.StringVal(cfg.Bucket))
+		setOptionalString(body, "description", cfg.Description)
+		body.SetAttributeValue("history", cty.NumberIntVal(cfg.History))
+		body.SetAttributeValue("ttl_seconds", cty.NumberIntVal(cfg.TTL/provider.NanosPerSecond))
+		body.SetAttributeValue("max_value_size", cty.NumberIntVal(cfg.MaxValueSize))
+		body.SetAttributeValue("max_bytes", cty.NumberIntVal(cfg.MaxBytes))
+		body.SetAttributeValue("storage", cty.StringVal(cfg.Storage))
+		body.SetAttributeValue("replicas", cty.NumberIntVal(cfg.Replicas))
+		setPlacement(body, cfg.Placement)
+	}
+
+	objects, err := g.client.ListObjectBuckets(ctx, account.ID)
+	if err != nil {
+		return fmt.Errorf("listing object buckets of account %s: %w", account.ID, err)
+	}
+	for _, b := range objects {
+		cfg := b.Config
+		body := g.add("synadia_object_bucket", account.Name+"_"+cfg.Bucket, account.ID+"/"+cfg.Bucket)
+		body.SetAttributeTraversal("account_id", accountRef)
+		body.SetAttributeValue("bucket", cty.StringVal(cfg.Bucket))
+		setOptionalString(body, "description", cfg.Description)
+		body.SetAttributeValue("max_bytes", cty.NumberIntVal(cfg.MaxBytes))
+		body.SetAttributeValue("storage", cty.StringVal(cfg.Storage))
+		body.SetAttributeValue("replicas", cty.NumberIntVal(cfg.Replicas))
+		setPlacement(body, cfg.Placement)
+	}
+
+	return nil
+}
+
+func (g *generator) emitConsumers(ctx context.Context, account *provider.Account, accountRef hcl.Traversal, streamLabel, stream string) error {
+	consumers, err := g.client.ListConsumers(ctx, account.ID, stream)
+	if err != nil {
+		return fmt.Errorf("listing consumers of stream %s in account %s: %w", stream, account.ID, err)
+	}
+	for _, c := range consumers {
+		// Ephemeral consumers disappear on their own and cannot be managed.
+		// synadia_consumer manages durables by their durable name, which is
+		// also what it is imported by.
+		cfg := c.Config
+		if cfg.Durable == "" {
+			continue
+		}
+
+		body := g.add("synadia_consumer", account.Name+"_"+stream+"_"+cfg.Durable, account.ID+"/"+stream+"/"+cfg.Durable)
+		body.SetAttributeTraversal("account_id", accountRef)
+		body.SetAttributeTraversal("stream_name", traversal("synadia_stream", streamLabel, "name"))
+		body.SetAttributeValue("name", cty.StringVal(cfg.Durable))
+		setOptionalString(body, "description", cfg.Description)
+		setOptionalString(body, "filter_subject", cfg.FilterSubject)
+		body.SetAttributeValue("deliver_policy", cty.StringVal(cfg.DeliverPolicy))
+		body.SetAttributeValue("ack_policy", cty.StringVal(cfg.AckPolicy))
+		setOptionalString(body, "deliver_subject", cfg.DeliverSubject)
+		body.SetAttributeValue("max_deliver", cty.NumberIntVal(cfg.MaxDeliver))
+	}
+	return nil
+}
+
+// add emits an import block and an empty resource block for the object and
+// returns the resource body for the caller to fill in.
+func (g *generator) add(resourceType, name, importID string) *hclwrite.Body {
+	return g.addLabeled(resourceType, g.label(name), importID)
+}
+
+func (g *generator) addLabeled(resourceType, label, importID string) *hclwrite.Body {
+	g.count++
+
+	imp := g.imports.Body().AppendNewBlock("import", nil).Body()
+	imp.SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: label},
+	})
+	imp.SetAttributeValue("id", cty.StringVal(importID))
+	g.imports.Body().AppendNewline()
+
+	block := g.resources.Body().AppendNewBlock("resource", []string{resourceType, label})
+	g.resources.Body().AppendNewline()
+	return block.Body()
+}
+
+// label turns name into a unique, valid Terraform resource label.
+func (g *generator) label(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '_':
+			b.WriteRune(r)
+		default:
+			b.WriteRune('_')
+		}
+	}
+
+	base := b.String()
+	if base == "" || (base[0] >= '0' && base[0] <= '9') {
+		base = "_" + base
+	}
+
+	label := base
+	for n := 2; g.labels[label]; n++ {
+		label = fmt.Sprintf("%s_%d", base, n)
+	}
+	g.labels[label] = true
+	return label
+}
+
+func traversal(resourceType, label, attr string) hcl.Traversal {
+	return hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: label},
+		hcl.TraverseAttr{Name: attr},
+	}
+}
+
+func setOptionalString(body *hclwrite.Body, name, value string) {
+	if value != "" {
+		body.SetAttributeValue(name, cty.StringVal(value))
+	}
+}
+
+func setPlacement(body *hclwrite.Body, p *provider.Placement) {
+	if p == nil || (p.Cluster == "" && len(p.Tags) == 0) {
+		return
+	}
+
+	attrs := map[string]cty.Value{}
+	if p.Cluster != "" {
+		attrs["cluster"] = cty.StringVal(p.Cluster)
+	}
+	if len(p.Tags) > 0 {
+		attrs["tags"] = cty.SetVal(stringList(p.Tags).AsValueSlice())
+	}
+	body.SetAttributeValue("placement", cty.ObjectVal(attrs))
+}
+
+func stringList(values []string) cty.Value {
+	if len(values) == 0 {
+		return cty.ListValEmpty(cty.String)
+	}
+	vals := make([]cty.Value, len(values))
+	for i, v := range values {
+		vals[i] = cty.StringVal(v)
+	}
+	return cty.ListVal(vals)
+}
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Command synadia-import-gen walks an existing Synadia Cloud team, system or
+// account and writes Terraform import blocks together with matching resource
+// configuration, so an existing tenant can be brought under Terraform
+// management without hand-writing every block.
+//
+// Usage:
+//
+//	synadia-import-gen -team TEAM_ID [-out DIR]
+//	synadia-import-gen -system SYSTEM_ID [-out DIR]
+//	synadia-import-gen -account ACCOUNT_ID [-out DIR]
+//
+// The endpoint and API token are resolved like the provider's: the -endpoint
+// flag, then SYNADIA_API_ENDPOINT and SYNADIA_API_TOKEN, then the profile
+// selected with -profile or SYNADIA_PROFILE in the credentials file.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/devonberta/terraform-provider-synadia-cloud/provider"
+)
+
+func main() {
+	var (
+		endpoint  string
+		profile   string
+		teamID    string
+		systemID  string
+		accountID string
+		outDir    string
+	)
+
+	flag.StringVar(&endpoint, "endpoint", "", "Synadia control plane endpoint (default from the environment, the profile or "+provider.DefaultEndpoint+")")
+	flag.StringVar(&profile, "profile", "", "credentials file profile to read the endpoint and token from (default SYNADIA_PROFILE or \"default\")")
+	flag.StringVar(&teamID, "team", "", "generate configuration for every system and account in this team")
+	flag.StringVar(&systemID, "system", "", "generate configuration for every account in this system")
+	flag.StringVar(&accountID, "account", "", "generate configuration for a single account")
+	flag.StringVar(&outDir, "out", ".", "directory the generated files are written to")
+	flag.Parse()
+
+	if countSet(teamID, systemID, accountID) != 1 {
+		log.Fatal("exactly one of -team, -system or -account must be set")
+	}
+
+	creds, err := provider.LoadCredentials(endpoint, "", profile)
+	if errors.Is(err, provider.ErrMissingToken) {
+		log.Fatal("no API token: set SYNADIA_API_TOKEN or a token in the credentials file profile")
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	client := provider.NewClient(creds.Endpoint, creds.Token, nil)
+	g := newGenerator(client)
+
+	ctx := context.Background()
+	switch {
+	case teamID != "":
+		err = g.walkTeam(ctx, teamID)
+	case systemID != "":
+		err = g.walkSystem(ctx, systemID)
+	default:
+		err = g.walkAccount(ctx, accountID)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.MkdirAll(outDir, 0o755); err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(outDir, "imports.tf"), g.imports.Bytes(), 0o644); err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(outDir, "generated.tf"), g.resources.Bytes(), 0o644); err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Fprintf(os.Stderr, "wrote %d import blocks to %s\n", g.count, outDir)
+}
+
+func countSet(values ...string) int {
+	n := 0
+	for _, v := range values {
+		if v != "" {
+			n++
+		}
+	}
+	return n
+}
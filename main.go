@@ -7,9 +7,10 @@ import (
 	"context"
 	"flag"
 	"log"
+	"time"
 
-	"github.com/hashicorp/terraform-plugin-framework/providerserver"
-	"github.com/devonberta/terraform-provider-synadia-cloud/internal/provider"
+	"github.com/devonberta/terraform-provider-synadia-cloud/provider"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
 )
 
 var (
@@ -21,21 +22,40 @@ var (
 	// https://goreleaser.com/cookbooks/using-main.version/
 )
 
+// providerAddress is the registry address the provider is published under.
+const providerAddress = "registry.terraform.io/devonberta/synadia-cloud"
+
+// tracingShutdownTimeout bounds the final export of trace spans.
+const tracingShutdownTimeout = time.Second
+
 func main() {
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	opts := providerserver.ServeOpts{
-		// TODO: Update this string with the published name of your provider.
-		// Also update the tfplugindocs generate command to either remove the
-		// -provider-name flag or set its value to the updated provider name.
-		Address: "registry.terraform.io/devonberta/synadia-cloud",
-		Debug:   debug,
+	ctx := context.Background()
+
+	serverFactory, err := newProviderServer(ctx, version)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	var serveOpts []tf6server.ServeOpt
+
+	if debug {
+		serveOpts = append(serveOpts, tf6server.WithManagedDebug())
 	}
 
-	err := providerserver.Serve(context.Background(), provider.New(version), opts)
+	err = tf6server.Serve(providerAddress, serverFactory, serveOpts...)
+
+	// Terraform waits briefly for the provider to exit after stopping it, so
+	// the remaining spans only get that long to be exported.
+	shutdownCtx, cancel := context.WithTimeout(ctx, tracingShutdownTimeout)
+	if err := provider.ShutdownTracing(shutdownCtx); err != nil {
+		log.Printf("shutting down tracing: %s", err)
+	}
+	cancel()
 
 	if err != nil {
 		log.Fatal(err.Error())
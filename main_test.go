@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/devonberta/terraform-provider-synadia-cloud/provider"
+	"github.com/devonberta/terraform-provider-synadia-cloud/sdkv2-version/providerconfig"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func providerSchema(t *testing.T, server tfprotov6.ProviderServer) *tfprotov6.GetProviderSchemaResponse {
+	t.Helper()
+
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema: %s", err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("GetProviderSchema: %s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	return resp
+}
+
+// TestProviderServer checks the server main serves. Built with the sdkv2 tag
+// it is the muxed server, which reports mismatched provider schemas and type
+// names served by both providers as error diagnostics.
+func TestProviderServer(t *testing.T) {
+	serverFactory, err := newProviderServer(context.Background(), "test")
+	if err != nil {
+		t.Fatalf("newProviderServer: %s", err)
+	}
+
+	providerSchema(t, serverFactory())
+}
+
+// TestProviderSchemasMatch muxes the framework provider with an SDKv2
+// provider that only declares the shared provider schema, so the schemas are
+// compared exactly as the muxed server does even without the sdkv2 tag.
+func TestProviderSchemasMatch(t *testing.T) {
+	ctx := context.Background()
+
+	sdkv2Server, err := tf5to6server.UpgradeServer(ctx, (&schema.Provider{Schema: providerconfig.Schema()}).GRPCProvider)
+	if err != nil {
+		t.Fatalf("UpgradeServer: %s", err)
+	}
+
+	muxServer, err := tf6muxserver.NewMuxServer(ctx,
+		providerserver.NewProtocol6(provider.New("test")()),
+		func() tfprotov6.ProviderServer { return sdkv2Server },
+	)
+	if err != nil {
+		t.Fatalf("NewMuxServer: %s", err)
+	}
+
+	providerSchema(t, muxServer.ProviderServer())
+}
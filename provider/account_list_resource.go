@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &AccountListResource{}
+var _ list.ListResourceWithConfigure = &AccountListResource{}
+
+func NewAccountListResource() list.ListResource {
+	return &AccountListResource{}
+}
+
+// AccountListResource defines the list resource implementation.
+type AccountListResource struct {
+	client *Client
+}
+
+// AccountListResourceModel describes the list resource config data model.
+type AccountListResourceModel struct {
+	SystemId   types.String `tfsdk:"system_id"`
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	Tag        types.String `tfsdk:"tag"`
+}
+
+func (r *AccountListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account"
+}
+
+func (r *AccountListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the NATS accounts of a system.",
+
+		Attributes: map[string]listschema.Attribute{
+			"system_id": listschema.StringAttribute{
+				MarkdownDescription: "System to list accounts in",
+				Required:            true,
+			},
+			"name_prefix": namePrefixAttribute("accounts"),
+			"tag":         tagAttribute("accounts"),
+		},
+	}
+}
+
+func (r *AccountListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AccountListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config AccountListResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	accounts, err := r.client.ListAccounts(ctx, config.SystemId.ValueString(), ListFilter{
+		Name: config.NamePrefix.ValueString(),
+		Tag:  config.Tag.ValueString(),
+	})
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list accounts, got error: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	filter := newListFilter(config.NamePrefix, config.Tag)
+	matched := make([]Account, 0, len(accounts))
+	for _, a := range accounts {
+		if filter.matches(a.Name, a.Tags) {
+			matched = append(matched, a)
+		}
+	}
+
+	stream.Results = listResults(ctx, req, matched, func(a *Account, result *list.ListResult) {
+		var data AccountResourceModel
+		data.fromAPI(a)
+		result.DisplayName = a.Name
+		result.Diagnostics.Append(result.Identity.Set(ctx, data.identity())...)
+		if req.IncludeResource {
+			result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+		}
+	})
+}
@@ -6,13 +6,11 @@ package provider
 import (
 	"context"
 	"fmt"
-	"net/http"
-
-	"os"
-	openapiclient "github.com/synadia-io/control-plane-sdk-go/syncp" // synadia control plane sdk
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -24,6 +22,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &AccountResource{}
 var _ resource.ResourceWithImportState = &AccountResource{}
+var _ resource.ResourceWithIdentity = &AccountResource{}
 
 func NewAccountResource() resource.Resource {
 	return &AccountResource{}
@@ -31,39 +30,66 @@ func NewAccountResource() resource.Resource {
 
 // AccountResource defines the resource implementation.
 type AccountResource struct {
-	client *http.Client
+	client *Client
 }
 
 // AccountResourceModel describes the resource data model.
 type AccountResourceModel struct {
-	ConfigurableAttribute types.String `tfsdk:"configurable_attribute"`
-	Defaulted             types.String `tfsdk:"defaulted"`
-	Id                    types.String `tfsdk:"id"`
+	Id          types.String `tfsdk:"id"`
+	SystemId    types.String `tfsdk:"system_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	PublicKey   types.String `tfsdk:"public_key"`
+}
+
+// accountAPIFields maps account request fields to schema attributes.
+var accountAPIFields = apiFieldPaths{
+	"name":        path.Root("name"),
+	"description": path.Root("description"),
+}
+
+// AccountResourceIdentityModel describes the resource identity data model.
+type AccountResourceIdentityModel struct {
+	Id types.String `tfsdk:"id"`
 }
 
 func (r *AccountResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_example"
+	resp.TypeName = req.ProviderTypeName + "_account"
 }
 
 func (r *AccountResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: "Example resource",
+		MarkdownDescription: "Manages a NATS account. Import with `<account_id>` or `<system_id>/<account_name>`.",
 
 		Attributes: map[string]schema.Attribute{
-			"configurable_attribute": schema.StringAttribute{
-				MarkdownDescription: "Example configurable attribute",
-				Optional:            true,
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Account identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"system_id": schema.StringAttribute{
+				MarkdownDescription: "System the account belongs to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Account name",
+				Required:            true,
 			},
-			"defaulted": schema.StringAttribute{
-				MarkdownDescription: "Example configurable attribute with default value",
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Account description",
 				Optional:            true,
 				Computed:            true,
-				Default:             stringdefault.StaticString("example value when not configured"),
+				Default:             stringdefault.StaticString(""),
 			},
-			"id": schema.StringAttribute{
+			"public_key": schema.StringAttribute{
+				MarkdownDescription: "Account NKey public key",
 				Computed:            true,
-				MarkdownDescription: "Example identifier",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -72,18 +98,29 @@ func (r *AccountResource) Schema(ctx context.Context, req resource.SchemaRequest
 	}
 }
 
+func (r *AccountResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "Account identifier",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *AccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*http.Client)
+	client, ok := req.ProviderData.(*Client)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -93,6 +130,9 @@ func (r *AccountResource) Configure(ctx context.Context, req resource.ConfigureR
 }
 
 func (r *AccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := r.client.startOperation(ctx, "synadia_account", "create")
+	defer func() { span.end(resp.Diagnostics) }()
+
 	var data AccountResourceModel
 
 	// Read Terraform plan data into the model
@@ -102,27 +142,33 @@ func (r *AccountResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
-	// httpResp, err := r.client.Do(httpReq)
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create example, got error: %s", err))
-	//     return
-	// }
-
-	// For the purposes of this example code, hardcoding a response value to
-	// save into the Terraform state.
-	data.Id = types.StringValue("example-id")
+	account, err := r.client.CreateAccount(ctx, data.SystemId.ValueString(), &AccountCreateRequest{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "create account", err, accountAPIFields)
+		return
+	}
 
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
-	tflog.Trace(ctx, "created a resource")
+	tflog.Trace(ctx, "created an account", map[string]any{"id": account.ID})
+
+	span.setAccount(account.ID)
+
+	data.fromAPI(account)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+	resp.Diagnostics.Append(saveRevision(ctx, resp.Private, account.Revision, account.managed())...)
 }
 
 func (r *AccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := r.client.startOperation(ctx, "synadia_account", "read")
+	defer func() { span.end(resp.Diagnostics) }()
+
 	var data AccountResourceModel
 
 	// Read Terraform prior state data into the model
@@ -132,19 +178,30 @@ func (r *AccountResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
-	// httpResp, err := r.client.Do(httpReq)
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read example, got error: %s", err))
-	//     return
-	// }
+	span.setAccount(data.Id.ValueString())
+
+	account, err := r.client.GetAccount(ctx, data.Id.ValueString())
+	if IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read account, got error: %s", err))
+		return
+	}
+
+	data.fromAPI(account)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+	resp.Diagnostics.Append(saveRevision(ctx, resp.Private, account.Revision, account.managed())...)
 }
 
 func (r *AccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := r.client.startOperation(ctx, "synadia_account", "update")
+	defer func() { span.end(resp.Diagnostics) }()
+
 	var data AccountResourceModel
 
 	// Read Terraform plan data into the model
@@ -154,19 +211,44 @@ func (r *AccountResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
-	// httpResp, err := r.client.Do(httpReq)
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update example, got error: %s", err))
-	//     return
-	// }
+	span.setAccount(data.Id.ValueString())
+
+	observed, diags := loadRevision(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	account, err := r.client.UpdateAccount(ctx, data.Id.ValueString(), &AccountUpdateRequest{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+	}, observed.Revision)
+	if IsConflict(err) {
+		current, getErr := r.client.GetAccount(ctx, data.Id.ValueString())
+		if getErr != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read changed account, got error: %s", getErr))
+			return
+		}
+		addConflictError(&resp.Diagnostics, "account", observed, current.managed())
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "update account", err, accountAPIFields)
+		return
+	}
+
+	data.fromAPI(account)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+	resp.Diagnostics.Append(saveRevision(ctx, resp.Private, account.Revision, account.managed())...)
 }
 
 func (r *AccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := r.client.startOperation(ctx, "synadia_account", "delete")
+	defer func() { span.end(resp.Diagnostics) }()
+
 	var data AccountResourceModel
 
 	// Read Terraform prior state data into the model
@@ -176,15 +258,58 @@ func (r *AccountResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
-	// httpResp, err := r.client.Do(httpReq)
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete example, got error: %s", err))
-	//     return
-	// }
+	span.setAccount(data.Id.ValueString())
+
+	err := r.client.DeleteAccount(ctx, data.SystemId.ValueString(), data.Id.ValueString())
+	if err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete account, got error: %s", err))
+		return
+	}
 }
 
+// ImportState accepts an account ID, "<system_id>/<account_name>" or an
+// import block identity.
 func (r *AccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	systemID, name, ok := strings.Cut(req.ID, "/")
+	if !ok {
+		resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+		return
+	}
+	if systemID == "" || name == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: account_id or system_id/account_name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	accounts, err := r.client.ListAccounts(ctx, systemID, ListFilter{Name: name})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list accounts, got error: %s", err))
+		return
+	}
+
+	for _, a := range accounts {
+		if a.Name == name {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), a.ID)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError(
+		"Account Not Found",
+		fmt.Sprintf("No account named %q exists in system %q.", name, systemID),
+	)
+}
+
+func (m *AccountResourceModel) fromAPI(account *Account) {
+	m.Id = types.StringValue(account.ID)
+	m.SystemId = types.StringValue(account.SystemID)
+	m.Name = types.StringValue(account.Name)
+	m.Description = types.StringValue(account.Description)
+	m.PublicKey = types.StringValue(account.PublicKey)
+}
+
+func (m *AccountResourceModel) identity() *AccountResourceIdentityModel {
+	return &AccountResourceIdentityModel{Id: m.Id}
 }
@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The accounts, team_accounts, users, team_nats_users and teams data sources
+// page through the control plane transparently and pass their name and tag
+// filters on to it, so only matching objects are transferred.
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AccountsDataSource{}
+
+func NewAccountsDataSource() datasource.DataSource {
+	return &AccountsDataSource{}
+}
+
+// AccountsDataSource defines the data source implementation.
+type AccountsDataSource struct {
+	client *Client
+}
+
+// AccountsDataSourceModel describes the data source data model.
+type AccountsDataSourceModel struct {
+	SystemId types.String          `tfsdk:"system_id"`
+	Name     types.String          `tfsdk:"name"`
+	Tag      types.String          `tfsdk:"tag"`
+	Accounts []AccountSummaryModel `tfsdk:"accounts"`
+}
+
+// AccountSummaryModel describes a single account entry.
+type AccountSummaryModel struct {
+	Id          types.String `tfsdk:"id"`
+	SystemId    types.String `tfsdk:"system_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	PublicKey   types.String `tfsdk:"public_key"`
+	Tags        []string     `tfsdk:"tags"`
+}
+
+func accountSummariesFromAPI(accounts []Account) []AccountSummaryModel {
+	out := make([]AccountSummaryModel, 0, len(accounts))
+	for _, a := range accounts {
+		out = append(out, AccountSummaryModel{
+			Id:          types.StringValue(a.ID),
+			SystemId:    types.StringValue(a.SystemID),
+			Name:        types.StringValue(a.Name),
+			Description: types.StringValue(a.Description),
+			PublicKey:   types.StringValue(a.PublicKey),
+			Tags:        append([]string{}, a.Tags...),
+		})
+	}
+	return out
+}
+
+func accountSummariesAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: "Matching accounts",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					MarkdownDescription: "Account identifier",
+					Computed:            true,
+				},
+				"system_id": schema.StringAttribute{
+					MarkdownDescription: "System the account belongs to",
+					Computed:            true,
+				},
+				"name": schema.StringAttribute{
+					MarkdownDescription: "Account name",
+					Computed:            true,
+				},
+				"description": schema.StringAttribute{
+					MarkdownDescription: "Account description",
+					Computed:            true,
+				},
+				"public_key": schema.StringAttribute{
+					MarkdownDescription: "Account public NKey",
+					Computed:            true,
+				},
+				"tags": schema.ListAttribute{
+					MarkdownDescription: "Tags of the account",
+					ElementType:         types.StringType,
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+// nameFilterAttribute and tagFilterAttribute describe the server-side filters
+// shared by the account, NATS user and team data sources.
+func nameFilterAttribute(kind string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("Only return %s whose name contains this string", kind),
+		Optional:            true,
+	}
+}
+
+func tagFilterAttribute(kind string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("Only return %s carrying this tag", kind),
+		Optional:            true,
+	}
+}
+
+func (d *AccountsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_accounts"
+}
+
+func (d *AccountsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the accounts of a system.",
+
+		Attributes: map[string]schema.Attribute{
+			"system_id": schema.StringAttribute{
+				MarkdownDescription: "System to list accounts in",
+				Required:            true,
+			},
+			"name":     nameFilterAttribute("accounts"),
+			"tag":      tagFilterAttribute("accounts"),
+			"accounts": accountSummariesAttribute(),
+		},
+	}
+}
+
+func (d *AccountsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AccountsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AccountsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accounts, err := d.client.ListAccounts(ctx, data.SystemId.ValueString(), ListFilter{
+		Name: data.Name.ValueString(),
+		Tag:  data.Tag.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list accounts, got error: %s", err))
+		return
+	}
+
+	data.Accounts = accountSummariesFromAPI(accounts)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
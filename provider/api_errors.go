@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// apiFieldPaths maps the JSON field names of a request body to the schema
+// attributes they are built from, so validation failures reported by the
+// control plane can be attached to the attribute that caused them. A field
+// mapped with a trailing "[]", e.g. "subjects[]", is a list attribute: an
+// index following it in a reported field selects the list element.
+type apiFieldPaths map[string]path.Path
+
+// lookup returns the attribute for field, falling back to its closest mapped
+// parent, so that e.g. "subjects.2" is reported on the third element of
+// subjects and "placement.tags.1" on the tags set.
+func (m apiFieldPaths) lookup(field string) (path.Path, bool) {
+	field = strings.ReplaceAll(field, "[", ".")
+	field = strings.ReplaceAll(field, "]", "")
+	segments := strings.Split(field, ".")
+
+	for n := len(segments); n > 0; n-- {
+		prefix := strings.Join(segments[:n], ".")
+		if p, ok := m[prefix]; ok {
+			return p, true
+		}
+		p, ok := m[prefix+"[]"]
+		if !ok {
+			continue
+		}
+		if n < len(segments) {
+			if i, err := strconv.Atoi(segments[n]); err == nil && i >= 0 {
+				return p.AtListIndex(i), true
+			}
+		}
+		return p, true
+	}
+
+	return path.Empty(), false
+}
+
+// placementAPIFields maps the placement block shared by streams and buckets.
+var placementAPIFields = apiFieldPaths{
+	"placement":         path.Root("placement"),
+	"placement.cluster": path.Root("placement").AtName("cluster"),
+	"placement.tags":    path.Root("placement").AtName("tags"),
+}
+
+// withPlacement returns m extended with placementAPIFields.
+func (m apiFieldPaths) withPlacement() apiFieldPaths {
+	out := maps.Clone(m)
+	maps.Copy(out, placementAPIFields)
+	return out
+}
+
+// addClientError reports a failed control plane call made to action (e.g.
+// "create stream"). Field errors the control plane attributes to a mapped
+// request field become attribute errors on that field; everything else is
+// reported as a general "Client Error", including the request ID.
+func addClientError(diags *diag.Diagnostics, action string, err error, fields apiFieldPaths) {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || len(apiErr.Fields) == 0 {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to %s, got error: %s", action, err))
+		return
+	}
+
+	var requestID string
+	if apiErr.RequestID != "" {
+		requestID = fmt.Sprintf(" (request ID %s)", apiErr.RequestID)
+	}
+
+	var unmapped []string
+	for _, f := range apiErr.Fields {
+		p, ok := fields.lookup(f.Field)
+		if !ok {
+			unmapped = append(unmapped, fmt.Sprintf("%s: %s", f.Field, f.Message))
+			continue
+		}
+		diags.AddAttributeError(p, "Invalid Attribute Value",
+			fmt.Sprintf("Unable to %s, the control plane rejected this value: %s%s", action, f.Message, requestID))
+	}
+
+	if len(unmapped) > 0 {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to %s, got error: synadia api returned %d: %s; %s%s",
+			action, apiErr.StatusCode, apiErr.Message, strings.Join(unmapped, "; "), requestID))
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestAPIFieldPaths_Lookup(t *testing.T) {
+	tests := map[string]struct {
+		field string
+		want  path.Path
+		ok    bool
+	}{
+		"field":                 {field: "description", want: path.Root("description"), ok: true},
+		"renamed field":         {field: "num_replicas", want: path.Root("replicas"), ok: true},
+		"list":                  {field: "subjects", want: path.Root("subjects"), ok: true},
+		"list element":          {field: "subjects.2", want: path.Root("subjects").AtListIndex(2), ok: true},
+		"list element brackets": {field: "subjects[0]", want: path.Root("subjects").AtListIndex(0), ok: true},
+		"nested field":          {field: "placement.cluster", want: path.Root("placement").AtName("cluster"), ok: true},
+		"set element":           {field: "placement.tags.1", want: path.Root("placement").AtName("tags"), ok: true},
+		"unmapped child":        {field: "placement.preferred", want: path.Root("placement"), ok: true},
+		"unknown field":         {field: "mirror.name"},
+		"empty field":           {field: ""},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, ok := streamAPIFields.lookup(tt.field)
+			if ok != tt.ok {
+				t.Fatalf("got ok %t, want %t", ok, tt.ok)
+			}
+			if ok && !got.Equal(tt.want) {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewAPIError(t *testing.T) {
+	tests := map[string]struct {
+		body   string
+		header string
+		want   APIError
+	}{
+		"structured body": {
+			body: `{"message":"invalid stream","request_id":"req-1",` +
+				`"errors":[{"field":"subjects.2","message":"overlaps orders.>"}]}`,
+			header: "req-header",
+			want: APIError{
+				StatusCode: http.StatusBadRequest,
+				Message:    "invalid stream",
+				RequestID:  "req-1",
+				Fields:     []FieldError{{Field: "subjects.2", Message: "overlaps orders.>"}},
+			},
+		},
+		"error key": {
+			body: `{"error":"invalid stream"}`,
+			want: APIError{StatusCode: http.StatusBadRequest, Message: "invalid stream"},
+		},
+		"non-JSON body": {
+			body:   "upstream connect error\n",
+			header: "req-2",
+			want:   APIError{StatusCode: http.StatusBadRequest, Message: "upstream connect error", RequestID: "req-2"},
+		},
+		"empty body": {
+			want: APIError{StatusCode: http.StatusBadRequest, Message: "Bad Request"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := &http.Response{StatusCode: http.StatusBadRequest, Header: http.Header{}}
+			if tt.header != "" {
+				resp.Header.Set("X-Request-Id", tt.header)
+			}
+
+			got := newAPIError(resp, []byte(tt.body))
+			if !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("got %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddClientError(t *testing.T) {
+	thirdSubject := path.Root("subjects").AtListIndex(2)
+
+	tests := map[string]struct {
+		err        error
+		wantPath   *path.Path
+		wantDetail []string
+	}{
+		"mapped list element": {
+			err: &APIError{
+				StatusCode: http.StatusBadRequest,
+				Message:    "invalid stream",
+				RequestID:  "req-1",
+				Fields:     []FieldError{{Field: "subjects.2", Message: "overlaps orders.>"}},
+			},
+			wantPath:   &thirdSubject,
+			wantDetail: []string{"overlaps orders.>", "request ID req-1"},
+		},
+		"unknown field": {
+			err: &APIError{
+				StatusCode: http.StatusBadRequest,
+				Message:    "invalid stream",
+				RequestID:  "req-2",
+				Fields:     []FieldError{{Field: "mirror.name", Message: "no such stream"}},
+			},
+			wantDetail: []string{"Unable to create stream", "400", "mirror.name: no such stream", "request ID req-2"},
+		},
+		"no field errors": {
+			err:        &APIError{StatusCode: http.StatusInternalServerError, Message: "boom", RequestID: "req-3"},
+			wantDetail: []string{"Unable to create stream", "500: boom", "request ID req-3"},
+		},
+		"not an API error": {
+			err:        errors.New("connection refused"),
+			wantDetail: []string{"Unable to create stream", "connection refused"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			addClientError(&diags, "create stream", tt.err, streamAPIFields)
+
+			if len(diags) != 1 {
+				t.Fatalf("got %d diagnostics, want 1: %v", len(diags), diags)
+			}
+			d := diags[0]
+
+			withPath, ok := d.(diag.DiagnosticWithPath)
+			switch {
+			case tt.wantPath == nil && ok:
+				t.Errorf("got attribute error on %s, want a plain error", withPath.Path())
+			case tt.wantPath != nil && !ok:
+				t.Errorf("got a plain error, want one on %s", *tt.wantPath)
+			case ok && !withPath.Path().Equal(*tt.wantPath):
+				t.Errorf("got error on %s, want %s", withPath.Path(), *tt.wantPath)
+			}
+
+			if !ok && d.Summary() != "Client Error" {
+				t.Errorf("got summary %q, want %q", d.Summary(), "Client Error")
+			}
+			for _, want := range tt.wantDetail {
+				if !strings.Contains(d.Detail(), want) {
+					t.Errorf("detail %q does not contain %q", d.Detail(), want)
+				}
+			}
+		})
+	}
+}
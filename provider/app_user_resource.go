@@ -0,0 +1,281 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AppUserResource{}
+var _ resource.ResourceWithImportState = &AppUserResource{}
+
+func NewAppUserResource() resource.Resource {
+	return &AppUserResource{}
+}
+
+// AppUserResource defines the resource implementation.
+type AppUserResource struct {
+	client *Client
+}
+
+// AppUserResourceModel describes the resource data model.
+type AppUserResourceModel struct {
+	Id                  types.String `tfsdk:"id"`
+	TeamId              types.String `tfsdk:"team_id"`
+	Email               types.String `tfsdk:"email"`
+	Name                types.String `tfsdk:"name"`
+	InvitationStatus    types.String `tfsdk:"invitation_status"`
+	InvitationExpiresAt types.String `tfsdk:"invitation_expires_at"`
+}
+
+// appUserAPIFields maps app user request fields to schema attributes.
+var appUserAPIFields = apiFieldPaths{
+	"email": path.Root("email"),
+	"name":  path.Root("name"),
+}
+
+func (r *AppUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_user"
+}
+
+func (r *AppUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a control plane (application) user. Creating the resource invites the user into the team; " +
+			"roles are granted with `synadia_app_user_role_binding`. Import with `<app_user_id>` or `<team_id>/<email>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "App user identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Team the user is invited into",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address the invitation is sent to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Display name of the user. Removing it clears the name",
+				Optional:            true,
+			},
+			"invitation_status": schema.StringAttribute{
+				MarkdownDescription: "Status of the team invitation: `pending`, `accepted` or `expired`",
+				Computed:            true,
+			},
+			"invitation_expires_at": schema.StringAttribute{
+				MarkdownDescription: "RFC 3339 timestamp after which a pending invitation expires",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *AppUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AppUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := r.client.startOperation(ctx, "synadia_app_user", "create")
+	defer func() { span.end(resp.Diagnostics) }()
+
+	var data AppUserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.client.CreateAppUser(ctx, data.TeamId.ValueString(), &AppUserCreateRequest{
+		Email: data.Email.ValueString(),
+		Name:  data.Name.ValueString(),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "create app user", err, appUserAPIFields)
+		return
+	}
+
+	tflog.Trace(ctx, "created an app user", map[string]any{"id": user.ID, "invitation_status": user.InvitationStatus})
+
+	data.fromAPI(user)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(saveRevision(ctx, resp.Private, user.Revision, user.managed())...)
+}
+
+func (r *AppUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := r.client.startOperation(ctx, "synadia_app_user", "read")
+	defer func() { span.end(resp.Diagnostics) }()
+
+	var data AppUserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.client.GetAppUser(ctx, data.Id.ValueString())
+	if IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read app user, got error: %s", err))
+		return
+	}
+
+	data.fromAPI(user)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(saveRevision(ctx, resp.Private, user.Revision, user.managed())...)
+}
+
+func (r *AppUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := r.client.startOperation(ctx, "synadia_app_user", "update")
+	defer func() { span.end(resp.Diagnostics) }()
+
+	var data AppUserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	observed, diags := loadRevision(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.client.UpdateAppUser(ctx, data.Id.ValueString(), &AppUserUpdateRequest{
+		Name: data.Name.ValueString(),
+	}, observed.Revision)
+	if IsConflict(err) {
+		current, getErr := r.client.GetAppUser(ctx, data.Id.ValueString())
+		if getErr != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read changed app user, got error: %s", getErr))
+			return
+		}
+		addConflictError(&resp.Diagnostics, "app user", observed, current.managed())
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "update app user", err, appUserAPIFields)
+		return
+	}
+
+	data.fromAPI(user)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(saveRevision(ctx, resp.Private, user.Revision, user.managed())...)
+}
+
+func (r *AppUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := r.client.startOperation(ctx, "synadia_app_user", "delete")
+	defer func() { span.end(resp.Diagnostics) }()
+
+	var data AppUserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteAppUser(ctx, data.Id.ValueString())
+	if err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete app user, got error: %s", err))
+		return
+	}
+}
+
+// ImportState accepts either an app user ID or "<team_id>/<email>".
+func (r *AppUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamID, email, ok := strings.Cut(req.ID, "/")
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+	if teamID == "" || email == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: app_user_id or team_id/email. Got: %q", req.ID),
+		)
+		return
+	}
+
+	users, err := r.client.ListTeamAppUsers(ctx, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list app users, got error: %s", err))
+		return
+	}
+
+	for _, u := range users {
+		if strings.EqualFold(u.Email, email) {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), u.ID)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError(
+		"App User Not Found",
+		fmt.Sprintf("No app user with email %q exists in team %q.", email, teamID),
+	)
+}
+
+func (m *AppUserResourceModel) fromAPI(user *AppUser) {
+	m.Id = types.StringValue(user.ID)
+	m.TeamId = types.StringValue(user.TeamID)
+	// The control plane may normalize the case of the address; keep the
+	// configured spelling so the result matches the plan.
+	if !strings.EqualFold(m.Email.ValueString(), user.Email) {
+		m.Email = types.StringValue(user.Email)
+	}
+	// An unset name is stored as empty.
+	if user.Name != "" || !m.Name.IsNull() {
+		m.Name = types.StringValue(user.Name)
+	}
+	m.InvitationStatus = types.StringValue(user.InvitationStatus)
+	m.InvitationExpiresAt = types.StringValue(user.InvitationExpiresAt)
+}
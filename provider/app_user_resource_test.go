@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAppUserResourceModel_FromAPI(t *testing.T) {
+	tests := map[string]struct {
+		model     AppUserResourceModel
+		user      AppUser
+		wantEmail types.String
+		wantName  types.String
+	}{
+		"configured email kept when only the case differs": {
+			model:     AppUserResourceModel{Email: types.StringValue("Alice@Example.com"), Name: types.StringValue("Alice")},
+			user:      AppUser{Email: "alice@example.com", Name: "Alice"},
+			wantEmail: types.StringValue("Alice@Example.com"),
+			wantName:  types.StringValue("Alice"),
+		},
+		"changed email": {
+			model:     AppUserResourceModel{Email: types.StringValue("alice@example.com"), Name: types.StringNull()},
+			user:      AppUser{Email: "bob@example.com"},
+			wantEmail: types.StringValue("bob@example.com"),
+			wantName:  types.StringNull(),
+		},
+		"unset name stays null": {
+			model:     AppUserResourceModel{Email: types.StringValue("alice@example.com"), Name: types.StringNull()},
+			user:      AppUser{Email: "alice@example.com"},
+			wantEmail: types.StringValue("alice@example.com"),
+			wantName:  types.StringNull(),
+		},
+		"empty name": {
+			model:     AppUserResourceModel{Email: types.StringValue("alice@example.com"), Name: types.StringValue("")},
+			user:      AppUser{Email: "alice@example.com"},
+			wantEmail: types.StringValue("alice@example.com"),
+			wantName:  types.StringValue(""),
+		},
+		"import": {
+			model:     AppUserResourceModel{Email: types.StringNull(), Name: types.StringNull()},
+			user:      AppUser{Email: "alice@example.com", Name: "Alice"},
+			wantEmail: types.StringValue("alice@example.com"),
+			wantName:  types.StringValue("Alice"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt.model.fromAPI(&tt.user)
+
+			if !tt.model.Email.Equal(tt.wantEmail) {
+				t.Errorf("got email %s, want %s", tt.model.Email, tt.wantEmail)
+			}
+			if !tt.model.Name.Equal(tt.wantName) {
+				t.Errorf("got name %s, want %s", tt.model.Name, tt.wantName)
+			}
+		})
+	}
+}
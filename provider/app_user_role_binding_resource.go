@@ -0,0 +1,383 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AppUserRoleBindingResource{}
+var _ resource.ResourceWithImportState = &AppUserRoleBindingResource{}
+var _ resource.ResourceWithValidateConfig = &AppUserRoleBindingResource{}
+var _ resource.ResourceWithModifyPlan = &AppUserRoleBindingResource{}
+
+func NewAppUserRoleBindingResource() resource.Resource {
+	return &AppUserRoleBindingResource{}
+}
+
+// AppUserRoleBindingResource defines the resource implementation.
+type AppUserRoleBindingResource struct {
+	client *Client
+}
+
+// AppUserRoleBindingResourceModel describes the resource data model.
+type AppUserRoleBindingResourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	AppUserId types.String `tfsdk:"app_user_id"`
+	Role      types.String `tfsdk:"role"`
+	RoleId    types.String `tfsdk:"role_id"`
+	Scope     types.String `tfsdk:"scope"`
+	ScopeId   types.String `tfsdk:"scope_id"`
+}
+
+// roleBindingAPIFields maps role binding request fields to schema attributes.
+// The role ID is resolved from the role name.
+var roleBindingAPIFields = apiFieldPaths{
+	"role_id":  path.Root("role"),
+	"scope":    path.Root("scope"),
+	"scope_id": path.Root("scope_id"),
+}
+
+func (r *AppUserRoleBindingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_user_role_binding"
+}
+
+func (r *AppUserRoleBindingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	replace := []planmodifier.String{
+		stringplanmodifier.RequiresReplace(),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Binds a role to an app user at organization, team, system or account scope. " +
+			"Role names are checked against the `synadia_roles` data source at plan time. " +
+			"Import with `<app_user_id>/<binding_id>` or `<email>/<role_name>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Role binding identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_user_id": schema.StringAttribute{
+				MarkdownDescription: "App user the role is granted to",
+				Required:            true,
+				PlanModifiers:       replace,
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Name of the role, as returned by the `synadia_roles` data source",
+				Required:            true,
+				PlanModifiers:       replace,
+			},
+			"role_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the bound role",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scope": schema.StringAttribute{
+				MarkdownDescription: "Scope of the binding: `org`, `team`, `system` or `account`",
+				Required:            true,
+				PlanModifiers:       replace,
+				Validators: []validator.String{
+					stringvalidator.OneOf(roleScopeOrg, roleScopeTeam, roleScopeSystem, roleScopeAccount),
+				},
+			},
+			"scope_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the team, system or account the binding applies to. Must be omitted for `org` scope.",
+				Optional:            true,
+				PlanModifiers:       replace,
+			},
+		},
+	}
+}
+
+func (r *AppUserRoleBindingResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AppUserRoleBindingResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Scope.IsUnknown() || data.ScopeId.IsUnknown() {
+		return
+	}
+
+	switch {
+	case data.Scope.ValueString() == roleScopeOrg && !data.ScopeId.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("scope_id"),
+			"Unexpected Scope ID",
+			"scope_id must not be set when scope is \"org\".",
+		)
+	case data.Scope.ValueString() != roleScopeOrg && data.ScopeId.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("scope_id"),
+			"Missing Scope ID",
+			fmt.Sprintf("scope_id is required when scope is %q.", data.Scope.ValueString()),
+		)
+	}
+}
+
+// ModifyPlan resolves the configured role name against the roles known to
+// the control plane so typos surface during plan rather than apply. The
+// lookup is skipped when role and scope are unchanged, keeping the bound
+// role's ID from state; otherwise the binding is replaced anyway, so the new
+// role_id is what Create binds.
+func (r *AppUserRoleBindingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var data AppUserRoleBindingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Role.IsUnknown() || data.Scope.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state AppUserRoleBindingResourceModel
+
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+		if resp.Diagnostics.HasError() || (data.Role.Equal(state.Role) && data.Scope.Equal(state.Scope)) {
+			return
+		}
+	}
+
+	role, available, err := r.client.FindRoleByName(ctx, data.Scope.ValueString(), data.Role.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list roles, got error: %s", err))
+		return
+	}
+
+	if role == nil {
+		names := make([]string, len(available))
+		for i, r := range available {
+			names[i] = r.Name
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root("role"),
+			"Unknown Role",
+			fmt.Sprintf("Role %q does not exist at %q scope. Valid roles are: %s.",
+				data.Role.ValueString(), data.Scope.ValueString(), strings.Join(names, ", ")),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("role_id"), role.ID)...)
+}
+
+func (r *AppUserRoleBindingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AppUserRoleBindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := r.client.startOperation(ctx, "synadia_app_user_role_binding", "create")
+	defer func() { span.end(resp.Diagnostics) }()
+
+	var data AppUserRoleBindingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	binding, err := r.client.CreateRoleBinding(ctx, data.AppUserId.ValueString(), &RoleBindingCreateRequest{
+		RoleID:  data.RoleId.ValueString(),
+		Scope:   data.Scope.ValueString(),
+		ScopeID: data.ScopeId.ValueString(),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "create role binding", err, roleBindingAPIFields)
+		return
+	}
+
+	tflog.Trace(ctx, "created an app user role binding", map[string]any{"id": binding.ID})
+
+	data.fromAPI(binding)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppUserRoleBindingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := r.client.startOperation(ctx, "synadia_app_user_role_binding", "read")
+	defer func() { span.end(resp.Diagnostics) }()
+
+	var data AppUserRoleBindingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	binding, err := r.client.GetRoleBinding(ctx, data.AppUserId.ValueString(), data.Id.ValueString())
+	if IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read role binding, got error: %s", err))
+		return
+	}
+
+	data.fromAPI(binding)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called with a real change since every configurable
+// attribute, and role_id resolved from them, forces replacement.
+func (r *AppUserRoleBindingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := r.client.startOperation(ctx, "synadia_app_user_role_binding", "update")
+	defer func() { span.end(resp.Diagnostics) }()
+
+	var data AppUserRoleBindingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppUserRoleBindingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := r.client.startOperation(ctx, "synadia_app_user_role_binding", "delete")
+	defer func() { span.end(resp.Diagnostics) }()
+
+	var data AppUserRoleBindingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteRoleBinding(ctx, data.AppUserId.ValueString(), data.Id.ValueString())
+	if err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete role binding, got error: %s", err))
+		return
+	}
+}
+
+func (r *AppUserRoleBindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: app_user_id/binding_id or email/role_name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	appUserID, bindingID := parts[0], parts[1]
+	if strings.Contains(parts[0], "@") {
+		binding, ok := r.findBindingByName(ctx, parts[0], parts[1], &resp.Diagnostics)
+		if !ok {
+			return
+		}
+		appUserID, bindingID = binding.AppUserID, binding.ID
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("app_user_id"), appUserID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), bindingID)...)
+}
+
+// findBindingByName resolves the email/role_name import form. It fails when
+// the role is bound more than once, e.g. at two scopes, since the name alone
+// does not say which binding is meant.
+func (r *AppUserRoleBindingResource) findBindingByName(ctx context.Context, email, role string, diags *diag.Diagnostics) (RoleBinding, bool) {
+	users, err := r.client.FindAppUsersByEmail(ctx, email)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list app users, got error: %s", err))
+		return RoleBinding{}, false
+	}
+	if len(users) == 0 {
+		diags.AddError("App User Not Found", fmt.Sprintf("No app user with email %q exists in any team.", email))
+		return RoleBinding{}, false
+	}
+
+	var matches []RoleBinding
+	for _, u := range users {
+		bindings, err := r.client.ListRoleBindings(ctx, u.ID)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to list role bindings, got error: %s", err))
+			return RoleBinding{}, false
+		}
+		for _, b := range bindings {
+			if b.RoleName == role {
+				matches = append(matches, b)
+			}
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		diags.AddError("Role Binding Not Found", fmt.Sprintf("The app user %q has no binding of role %q.", email, role))
+		return RoleBinding{}, false
+	case 1:
+		return matches[0], true
+	}
+
+	ids := make([]string, len(matches))
+	for i, b := range matches {
+		ids[i] = fmt.Sprintf("%s/%s (%s scope %s)", b.AppUserID, b.ID, b.Scope, b.ScopeID)
+	}
+	diags.AddError(
+		"Ambiguous Role Binding",
+		fmt.Sprintf("The app user %q has %d bindings of role %q. Import one of them by app_user_id/binding_id: %s",
+			email, len(matches), role, strings.Join(ids, ", ")),
+	)
+	return RoleBinding{}, false
+}
+
+func (m *AppUserRoleBindingResourceModel) fromAPI(b *RoleBinding) {
+	m.Id = types.StringValue(b.ID)
+	m.AppUserId = types.StringValue(b.AppUserID)
+	m.Role = types.StringValue(b.RoleName)
+	m.RoleId = types.StringValue(b.RoleID)
+	m.Scope = types.StringValue(b.Scope)
+	if b.ScopeID == "" {
+		m.ScopeId = types.StringNull()
+	} else {
+		m.ScopeId = types.StringValue(b.ScopeID)
+	}
+}
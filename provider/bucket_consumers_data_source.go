@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BucketConsumersDataSource{}
+
+// NewKVConsumersDataSource returns the data source listing the consumers of a
+// KV bucket, i.e. its watchers.
+func NewKVConsumersDataSource() datasource.DataSource {
+	return &BucketConsumersDataSource{typeName: "_kv_consumers", kind: "key value", streamPrefix: KVStreamPrefix}
+}
+
+// NewObjectConsumersDataSource returns the data source listing the consumers
+// of an object bucket.
+func NewObjectConsumersDataSource() datasource.DataSource {
+	return &BucketConsumersDataSource{typeName: "_object_consumers", kind: "object store", streamPrefix: ObjectStreamPrefix}
+}
+
+// BucketConsumersDataSource defines the data source implementation. Consumers
+// are listed on the stream backing the bucket.
+type BucketConsumersDataSource struct {
+	client       *Client
+	typeName     string
+	kind         string
+	streamPrefix string
+}
+
+// BucketConsumersDataSourceModel describes the data source data model.
+type BucketConsumersDataSourceModel struct {
+	AccountId   types.String           `tfsdk:"account_id"`
+	Bucket      types.String           `tfsdk:"bucket"`
+	DurableName types.String           `tfsdk:"durable_name"`
+	Type        types.String           `tfsdk:"type"`
+	Consumers   []ConsumerSummaryModel `tfsdk:"consumers"`
+}
+
+func (d *BucketConsumersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + d.typeName
+}
+
+func (d *BucketConsumersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := map[string]schema.Attribute{
+		"account_id": schema.StringAttribute{
+			MarkdownDescription: "Account the bucket belongs to",
+			Required:            true,
+		},
+		"bucket": schema.StringAttribute{
+			MarkdownDescription: "Bucket to list consumers of",
+			Required:            true,
+		},
+	}
+	maps.Copy(attrs, consumerListAttributes())
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Lists the consumers of a JetStream %s bucket together with their runtime "+
+			"state. Consumers are reported on the bucket's backing stream `%s<bucket>`.", d.kind, d.streamPrefix),
+
+		Attributes: attrs,
+	}
+}
+
+func (d *BucketConsumersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *BucketConsumersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BucketConsumersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountID := data.AccountId.ValueString()
+
+	consumers, err := d.client.ListConsumers(ctx, accountID, d.streamPrefix+data.Bucket.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list bucket consumers, got error: %s", err))
+		return
+	}
+
+	data.Consumers = []ConsumerSummaryModel{}
+	for i := range consumers {
+		c := &consumers[i]
+		if !consumerMatches(c, data.DurableName, data.Type) {
+			continue
+		}
+		data.Consumers = append(data.Consumers, consumerSummaryFromAPI(accountID, c))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,290 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// apiBasePath is the prefix shared by all Synadia Cloud control plane endpoints.
+const apiBasePath = "/api/core/beta"
+
+// Client is a thin wrapper around the Synadia Cloud control plane REST API.
+// A single Client is created per provider instance and handed to every
+// resource and data source through ProviderData.
+type Client struct {
+	endpoint   string
+	token      string
+	httpClient *http.Client
+
+	// streamSubjects tracks the subjects of streams planned through this
+	// provider instance; see StreamResource.ModifyPlan.
+	streamSubjects *streamSubjectRegistry
+
+	// placementOptions caches each system's placement options for
+	// validatePlacement, so planning many assets fetches them once.
+	placementMu      sync.Mutex
+	placementOptions map[string]*PlacementOptions
+
+	// jwtLocks serializes calls that re-sign the same account or system JWT;
+	// see keyedLocks and sharedJWTLocks.
+	jwtLocks *keyedLocks
+
+	// tracing creates operation spans; nil when tracing is disabled.
+	tracing *tracing
+}
+
+// NewClient returns a Client talking to endpoint and authenticating with token.
+// A nil httpClient uses the default transport with the default retry policy
+// and concurrency limit.
+func NewClient(endpoint, token string, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = &http.Client{
+			Transport: newRetryTransport(newLimitTransport(nil, defaultMaxConcurrentRequests, 0), defaultMaxRetries),
+		}
+	}
+
+	return &Client{
+		endpoint:         strings.TrimRight(endpoint, "/"),
+		token:            token,
+		httpClient:       httpClient,
+		streamSubjects:   newStreamSubjectRegistry(),
+		placementOptions: map[string]*PlacementOptions{},
+		jwtLocks:         sharedJWTLocks,
+	}
+}
+
+// APIError is returned when the control plane answers with a non-2xx status.
+type APIError struct {
+	StatusCode int
+	Message    string
+
+	// RequestID identifies the failed request in control plane logs and
+	// should be quoted in support tickets.
+	RequestID string
+
+	// Fields lists validation failures attributed to individual fields of
+	// the request body.
+	Fields []FieldError
+}
+
+// FieldError is a validation failure for one field of a request body. Field
+// is the dotted JSON path of the field, e.g. "placement.cluster".
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("synadia api returned %d: %s", e.StatusCode, e.Message)
+	for _, f := range e.Fields {
+		msg += fmt.Sprintf("; %s: %s", f.Field, f.Message)
+	}
+	if e.RequestID != "" {
+		msg += fmt.Sprintf(" (request ID %s)", e.RequestID)
+	}
+	return msg
+}
+
+// IsConflict reports whether err is an APIError for a conditional update
+// rejected because the object changed since its revision was read.
+func IsConflict(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusPreconditionFailed
+}
+
+// revisioned is embedded in API objects to capture the ETag the control plane
+// returned with them. Update calls send it back in If-Match, so they fail
+// with 412 instead of overwriting a change made after the object was read.
+type revisioned struct {
+	Revision string `json:"-"`
+}
+
+func (r *revisioned) setRevision(etag string) {
+	r.Revision = etag
+}
+
+// ifMatch wraps a request body to make the call conditional on the object
+// still being at revision. An empty revision sends the body unconditionally.
+type ifMatch struct {
+	body     any
+	revision string
+}
+
+// IsNotFound reports whether err is an APIError for a missing object.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// do sends a JSON request to the control plane and decodes the response into
+// out when it is non-nil.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body, out any) error {
+	u := c.endpoint + apiBasePath + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	var revision string
+	if cond, ok := body.(ifMatch); ok {
+		body, revision = cond.body, cond.revision
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("encoding request body: %w", err)
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if revision != "" {
+		req.Header.Set("If-Match", revision)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading response body: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return newAPIError(resp, respBody)
+	}
+
+	if out == nil || len(respBody) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("decoding response body: %w", err)
+	}
+
+	if r, ok := out.(interface{ setRevision(string) }); ok {
+		r.setRevision(resp.Header.Get("ETag"))
+	}
+
+	return nil
+}
+
+// apiErrorBody is the structured error body of the control plane. Older
+// endpoints answer with plain text instead.
+type apiErrorBody struct {
+	Message   string       `json:"message"`
+	Error     string       `json:"error"`
+	RequestID string       `json:"request_id"`
+	Errors    []FieldError `json:"errors"`
+}
+
+func newAPIError(resp *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Message:    strings.TrimSpace(string(body)),
+		RequestID:  resp.Header.Get("X-Request-Id"),
+	}
+
+	var parsed apiErrorBody
+	if err := json.Unmarshal(body, &parsed); err == nil {
+		apiErr.Message = cmp.Or(parsed.Message, parsed.Error)
+		if parsed.RequestID != "" {
+			apiErr.RequestID = parsed.RequestID
+		}
+		apiErr.Fields = parsed.Errors
+	}
+
+	if apiErr.Message == "" {
+		apiErr.Message = http.StatusText(resp.StatusCode)
+	}
+
+	return apiErr
+}
+
+// listResponse is the envelope used by list endpoints. NextPageToken is set
+// while more pages are available.
+type listResponse[T any] struct {
+	Items         []T    `json:"items"`
+	NextPageToken string `json:"next_page_token,omitempty"`
+}
+
+// listPageSize is the number of items requested per page of a list endpoint.
+const listPageSize = 500
+
+// listAll fetches every page of a list endpoint and returns the concatenated
+// items. query carries server-side filters and may be nil.
+func listAll[T any](ctx context.Context, c *Client, path string, query url.Values) ([]T, error) {
+	q := maps.Clone(query)
+	if q == nil {
+		q = url.Values{}
+	}
+	q.Set("page_size", strconv.Itoa(listPageSize))
+
+	var items []T
+	for {
+		var out listResponse[T]
+		if err := c.do(ctx, http.MethodGet, path, q, nil, &out); err != nil {
+			return nil, err
+		}
+		items = append(items, out.Items...)
+
+		if out.NextPageToken == "" {
+			return items, nil
+		}
+		if out.NextPageToken == q.Get("page_token") {
+			return nil, fmt.Errorf("listing %s: control plane returned page token %q twice", path, out.NextPageToken)
+		}
+		q.Set("page_token", out.NextPageToken)
+	}
+}
+
+// ListFilter narrows the results of account and NATS user list endpoints on
+// the server. Empty fields are not sent.
+type ListFilter struct {
+	// Name matches objects whose name contains it.
+	Name string
+	// Tag matches objects carrying it.
+	Tag string
+	// AccountID restricts team wide NATS user lists to a single account.
+	AccountID string
+}
+
+func (f ListFilter) query() url.Values {
+	q := url.Values{}
+	if f.Name != "" {
+		q.Set("name", f.Name)
+	}
+	if f.Tag != "" {
+		q.Set("tag", f.Tag)
+	}
+	if f.AccountID != "" {
+		q.Set("account_id", f.AccountID)
+	}
+	return q
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// Account is a NATS account managed by a Synadia Cloud system.
+type Account struct {
+	ID          string   `json:"id"`
+	SystemID    string   `json:"system_id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	PublicKey   string   `json:"account_public_key"`
+	Tags        []string `json:"tags,omitempty"`
+
+	revisioned
+}
+
+// AccountCreateRequest creates a new account in a system.
+type AccountCreateRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// AccountUpdateRequest updates mutable account fields.
+type AccountUpdateRequest struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description"`
+}
+
+// managed returns the fields synadia_account manages, which are what its
+// updates compare against when they conflict.
+func (a *Account) managed() AccountUpdateRequest {
+	return AccountUpdateRequest{Name: a.Name, Description: a.Description}
+}
+
+func (c *Client) ListAccounts(ctx context.Context, systemID string, filter ListFilter) ([]Account, error) {
+	path := "/systems/" + url.PathEscape(systemID) + "/accounts"
+	return listAll[Account](ctx, c, path, filter.query())
+}
+
+// ListTeamAccounts lists the accounts of every system owned by a team.
+func (c *Client) ListTeamAccounts(ctx context.Context, teamID string, filter ListFilter) ([]Account, error) {
+	path := "/teams/" + url.PathEscape(teamID) + "/accounts"
+	return listAll[Account](ctx, c, path, filter.query())
+}
+
+// CreateAccount and DeleteAccount change the system's account list and are
+// serialized per system.
+func (c *Client) CreateAccount(ctx context.Context, systemID string, req *AccountCreateRequest) (*Account, error) {
+	unlock, err := c.lockSystem(ctx, systemID)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	var out Account
+	path := "/systems/" + url.PathEscape(systemID) + "/accounts"
+	if err := c.do(ctx, http.MethodPost, path, nil, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) GetAccount(ctx context.Context, id string) (*Account, error) {
+	var out Account
+	if err := c.do(ctx, http.MethodGet, "/accounts/"+url.PathEscape(id), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateAccount re-signs the account JWT and is serialized per account.
+func (c *Client) UpdateAccount(ctx context.Context, id string, req *AccountUpdateRequest, revision string) (*Account, error) {
+	unlock, err := c.lockAccount(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	var out Account
+	if err := c.do(ctx, http.MethodPatch, "/accounts/"+url.PathEscape(id), nil, ifMatch{req, revision}, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) DeleteAccount(ctx context.Context, systemID, id string) error {
+	unlock, err := c.lockSystem(ctx, systemID)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	return c.do(ctx, http.MethodDelete, "/accounts/"+url.PathEscape(id), nil, nil, nil)
+}
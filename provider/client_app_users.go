@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Role is a named set of policies that can be bound to an app user.
+type Role struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Scope       string `json:"scope"`
+}
+
+// AppUser is a control plane (human) user that belongs to a team.
+type AppUser struct {
+	ID                  string `json:"id"`
+	TeamID              string `json:"team_id"`
+	Email               string `json:"email"`
+	Name                string `json:"name"`
+	InvitationStatus    string `json:"invitation_status"`
+	InvitationExpiresAt string `json:"invitation_expires_at"`
+
+	revisioned
+}
+
+// AppUserCreateRequest invites a new app user into a team.
+type AppUserCreateRequest struct {
+	Email string `json:"email"`
+	Name  string `json:"name,omitempty"`
+}
+
+// AppUserUpdateRequest updates mutable app user fields. Name is always sent
+// so that it can be cleared.
+type AppUserUpdateRequest struct {
+	Name string `json:"name"`
+}
+
+// managed returns the fields synadia_app_user manages, which are what its
+// updates compare against when they conflict. Invitation status and expiry
+// are left out as they change on their own.
+func (u *AppUser) managed() AppUserCreateRequest {
+	return AppUserCreateRequest{Email: u.Email, Name: u.Name}
+}
+
+// RoleBinding grants a role to an app user at a given scope.
+type RoleBinding struct {
+	ID        string `json:"id"`
+	AppUserID string `json:"app_user_id"`
+	RoleID    string `json:"role_id"`
+	RoleName  string `json:"role_name"`
+	Scope     string `json:"scope"`
+	ScopeID   string `json:"scope_id"`
+}
+
+// RoleBindingCreateRequest binds a role to an app user.
+type RoleBindingCreateRequest struct {
+	RoleID  string `json:"role_id"`
+	Scope   string `json:"scope"`
+	ScopeID string `json:"scope_id,omitempty"`
+}
+
+// Role binding scopes supported by the control plane.
+const (
+	roleScopeOrg     = "org"
+	roleScopeTeam    = "team"
+	roleScopeSystem  = "system"
+	roleScopeAccount = "account"
+)
+
+func (c *Client) ListRoles(ctx context.Context) ([]Role, error) {
+	return listAll[Role](ctx, c, "/roles", nil)
+}
+
+// FindRoleByName returns the role called name within scope, or nil when no
+// such role exists, together with every role available in that scope so
+// callers can report the valid choices.
+func (c *Client) FindRoleByName(ctx context.Context, scope, name string) (*Role, []Role, error) {
+	roles, err := c.ListRoles(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var scoped []Role
+	for _, r := range roles {
+		if r.Scope != scope {
+			continue
+		}
+		scoped = append(scoped, r)
+		if r.Name == name {
+			role := r
+			return &role, scoped, nil
+		}
+	}
+	return nil, scoped, nil
+}
+
+func (c *Client) ListTeamAppUsers(ctx context.Context, teamID string) ([]AppUser, error) {
+	path := "/teams/" + url.PathEscape(teamID) + "/app-users"
+	return listAll[AppUser](ctx, c, path, nil)
+}
+
+// FindAppUsersByEmail returns the app users invited with email in any team the
+// API token has access to. A person invited into several teams has one app
+// user per team.
+func (c *Client) FindAppUsersByEmail(ctx context.Context, email string) ([]AppUser, error) {
+	teams, err := c.ListTeams(ctx, ListFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	var found []AppUser
+	for _, t := range teams {
+		users, err := c.ListTeamAppUsers(ctx, t.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range users {
+			if strings.EqualFold(u.Email, email) {
+				found = append(found, u)
+			}
+		}
+	}
+	return found, nil
+}
+
+func (c *Client) CreateAppUser(ctx context.Context, teamID string, req *AppUserCreateRequest) (*AppUser, error) {
+	var out AppUser
+	path := "/teams/" + url.PathEscape(teamID) + "/app-users"
+	if err := c.do(ctx, http.MethodPost, path, nil, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) GetAppUser(ctx context.Context, id string) (*AppUser, error) {
+	var out AppUser
+	if err := c.do(ctx, http.MethodGet, "/app-users/"+url.PathEscape(id), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) UpdateAppUser(ctx context.Context, id string, req *AppUserUpdateRequest, revision string) (*AppUser, error) {
+	var out AppUser
+	if err := c.do(ctx, http.MethodPatch, "/app-users/"+url.PathEscape(id), nil, ifMatch{req, revision}, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) DeleteAppUser(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, "/app-users/"+url.PathEscape(id), nil, nil, nil)
+}
+
+func (c *Client) ListRoleBindings(ctx context.Context, appUserID string) ([]RoleBinding, error) {
+	path := "/app-users/" + url.PathEscape(appUserID) + "/role-bindings"
+	return listAll[RoleBinding](ctx, c, path, nil)
+}
+
+func (c *Client) CreateRoleBinding(ctx context.Context, appUserID string, req *RoleBindingCreateRequest) (*RoleBinding, error) {
+	var out RoleBinding
+	path := "/app-users/" + url.PathEscape(appUserID) + "/role-bindings"
+	if err := c.do(ctx, http.MethodPost, path, nil, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) GetRoleBinding(ctx context.Context, appUserID, id string) (*RoleBinding, error) {
+	var out RoleBinding
+	path := "/app-users/" + url.PathEscape(appUserID) + "/role-bindings/" + url.PathEscape(id)
+	if err := c.do(ctx, http.MethodGet, path, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) DeleteRoleBinding(ctx context.Context, appUserID, id string) error {
+	path := "/app-users/" + url.PathEscape(appUserID) + "/role-bindings/" + url.PathEscape(id)
+	return c.do(ctx, http.MethodDelete, path, nil, nil, nil)
+}
@@ -0,0 +1,356 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// StreamConfig mirrors the JetStream stream configuration. Durations are in
+// nanoseconds, matching the JetStream JSON API.
+type StreamConfig struct {
+	Name        string     `json:"name"`
+	Description string     `json:"description,omitempty"`
+	Subjects    []string   `json:"subjects,omitempty"`
+	Retention   string     `json:"retention"`
+	Storage     string     `json:"storage"`
+	Replicas    int64      `json:"num_replicas"`
+	MaxMsgs     int64      `json:"max_msgs"`
+	MaxBytes    int64      `json:"max_bytes"`
+	MaxAge      int64      `json:"max_age"`
+	Placement   *Placement `json:"placement,omitempty"`
+}
+
+// Placement restricts where JetStream places an asset's replicas: in a named
+// cluster and/or on servers carrying all of the given tags.
+type Placement struct {
+	Cluster string   `json:"cluster,omitempty"`
+	Tags    []string `json:"tags,omitempty"`
+}
+
+// PlacementOptions lists the clusters and server tags available for placement
+// in a system.
+type PlacementOptions struct {
+	Clusters []string `json:"clusters"`
+	Tags     []string `json:"tags"`
+}
+
+// Stream is a JetStream stream as returned by the control plane.
+type Stream struct {
+	Config  StreamConfig       `json:"config"`
+	State   *StreamState       `json:"state,omitempty"`
+	Cluster *StreamClusterInfo `json:"cluster,omitempty"`
+	Tags    []string           `json:"tags,omitempty"`
+
+	revisioned
+}
+
+// StreamState is the runtime state of a stream, or of the stream backing a
+// KV or object bucket.
+type StreamState struct {
+	Messages      int64 `json:"messages"`
+	Bytes         int64 `json:"bytes"`
+	FirstSeq      int64 `json:"first_seq"`
+	LastSeq       int64 `json:"last_seq"`
+	ConsumerCount int64 `json:"consumer_count"`
+}
+
+// StreamClusterInfo reports the RAFT group of a replicated stream. Replicas
+// lists the followers only; the leader is reported separately.
+type StreamClusterInfo struct {
+	Leader   string           `json:"leader,omitempty"`
+	Replicas []StreamPeerInfo `json:"replicas,omitempty"`
+}
+
+// StreamPeerInfo describes a single follower of a replicated stream.
+type StreamPeerInfo struct {
+	Name    string `json:"name"`
+	Current bool   `json:"current"`
+	Offline bool   `json:"offline,omitempty"`
+}
+
+// ready reports whether the stream has a leader and every follower has caught
+// up, along with a short status for progress logs.
+func (s *Stream) ready() (bool, string) {
+	if s.Cluster == nil {
+		return true, "ready"
+	}
+	if s.Cluster.Leader == "" {
+		return false, "electing leader"
+	}
+
+	followers := int(max(s.Config.Replicas-1, 0))
+	current := 0
+	for _, r := range s.Cluster.Replicas {
+		if r.Current && !r.Offline {
+			current++
+		}
+	}
+
+	status := fmt.Sprintf("leader %s, %d/%d replicas current", s.Cluster.Leader, current, followers)
+	return current >= followers, status
+}
+
+// ConsumerConfig mirrors the JetStream consumer configuration. A consumer
+// with a deliver subject is a push consumer, otherwise it is a pull consumer.
+type ConsumerConfig struct {
+	Durable        string `json:"durable_name"`
+	Description    string `json:"description,omitempty"`
+	FilterSubject  string `json:"filter_subject,omitempty"`
+	DeliverPolicy  string `json:"deliver_policy"`
+	AckPolicy      string `json:"ack_policy"`
+	DeliverSubject string `json:"deliver_subject,omitempty"`
+	MaxDeliver     int64  `json:"max_deliver,omitempty"`
+}
+
+// Consumer is a JetStream consumer as returned by the control plane, along
+// with its runtime state.
+type Consumer struct {
+	StreamName     string         `json:"stream_name"`
+	Name           string         `json:"name"`
+	Config         ConsumerConfig `json:"config"`
+	Delivered      *SequenceInfo  `json:"delivered,omitempty"`
+	NumAckPending  int64          `json:"num_ack_pending"`
+	NumRedelivered int64          `json:"num_redelivered"`
+	NumPending     int64          `json:"num_pending"`
+
+	revisioned
+}
+
+// SequenceInfo reports the last message a consumer delivered and when.
+type SequenceInfo struct {
+	ConsumerSeq int64      `json:"consumer_seq"`
+	StreamSeq   int64      `json:"stream_seq"`
+	LastActive  *time.Time `json:"last_active,omitempty"`
+}
+
+// A stream mirroring another stream reads from it through an ephemeral push
+// consumer delivering to a subject with this prefix.
+const mirrorDeliverPrefix = "$JS.M."
+
+// isMirror reports whether the consumer was created by a mirroring stream.
+func (c *Consumer) isMirror() bool {
+	return strings.HasPrefix(c.Config.DeliverSubject, mirrorDeliverPrefix)
+}
+
+// KVBucketConfig describes a JetStream key value bucket.
+type KVBucketConfig struct {
+	Bucket       string     `json:"bucket"`
+	Description  string     `json:"description,omitempty"`
+	History      int64      `json:"history"`
+	TTL          int64      `json:"ttl"`
+	MaxValueSize int64      `json:"max_value_size"`
+	MaxBytes     int64      `json:"max_bytes"`
+	Storage      string     `json:"storage"`
+	Replicas     int64      `json:"replicas"`
+	Placement    *Placement `json:"placement,omitempty"`
+}
+
+// KVBucket is a key value bucket as returned by the control plane.
+type KVBucket struct {
+	Config KVBucketConfig `json:"config"`
+	State  *StreamState   `json:"state,omitempty"`
+	Tags   []string       `json:"tags,omitempty"`
+
+	revisioned
+}
+
+// ObjectBucketConfig describes a JetStream object store bucket.
+type ObjectBucketConfig struct {
+	Bucket      string     `json:"bucket"`
+	Description string     `json:"description,omitempty"`
+	MaxBytes    int64      `json:"max_bytes"`
+	Storage     string     `json:"storage"`
+	Replicas    int64      `json:"replicas"`
+	Placement   *Placement `json:"placement,omitempty"`
+}
+
+// ObjectBucket is an object store bucket as returned by the control plane.
+type ObjectBucket struct {
+	Config ObjectBucketConfig `json:"config"`
+	State  *StreamState       `json:"state,omitempty"`
+	Tags   []string           `json:"tags,omitempty"`
+
+	revisioned
+}
+
+func (c *Client) GetPlacementOptions(ctx context.Context, systemID string) (*PlacementOptions, error) {
+	var out PlacementOptions
+	path := "/systems/" + url.PathEscape(systemID) + "/jetstream/placement-options"
+	if err := c.do(ctx, http.MethodGet, path, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// cachedPlacementOptions is GetPlacementOptions remembering successful
+// responses for the life of the Client. Placement options change rarely, and
+// a stale answer only affects plan-time validation.
+func (c *Client) cachedPlacementOptions(ctx context.Context, systemID string) (*PlacementOptions, error) {
+	c.placementMu.Lock()
+	options, ok := c.placementOptions[systemID]
+	c.placementMu.Unlock()
+	if ok {
+		return options, nil
+	}
+
+	options, err := c.GetPlacementOptions(ctx, systemID)
+	if err != nil {
+		return nil, err
+	}
+
+	c.placementMu.Lock()
+	c.placementOptions[systemID] = options
+	c.placementMu.Unlock()
+	return options, nil
+}
+
+func streamsPath(accountID string) string {
+	return "/accounts/" + url.PathEscape(accountID) + "/jetstream/streams"
+}
+
+func consumersPath(accountID, stream string) string {
+	return streamsPath(accountID) + "/" + url.PathEscape(stream) + "/consumers"
+}
+
+func kvBucketsPath(accountID string) string {
+	return "/accounts/" + url.PathEscape(accountID) + "/jetstream/kv"
+}
+
+func objectBucketsPath(accountID string) string {
+	return "/accounts/" + url.PathEscape(accountID) + "/jetstream/object"
+}
+
+func (c *Client) ListStreams(ctx context.Context, accountID string) ([]Stream, error) {
+	return listAll[Stream](ctx, c, streamsPath(accountID), nil)
+}
+
+func (c *Client) CreateStream(ctx context.Context, accountID string, cfg *StreamConfig) (*Stream, error) {
+	var out Stream
+	if err := c.do(ctx, http.MethodPost, streamsPath(accountID), nil, cfg, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) GetStream(ctx context.Context, accountID, name string) (*Stream, error) {
+	var out Stream
+	if err := c.do(ctx, http.MethodGet, streamsPath(accountID)+"/"+url.PathEscape(name), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) UpdateStream(ctx context.Context, accountID string, cfg *StreamConfig, revision string) (*Stream, error) {
+	var out Stream
+	if err := c.do(ctx, http.MethodPut, streamsPath(accountID)+"/"+url.PathEscape(cfg.Name), nil, ifMatch{cfg, revision}, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) DeleteStream(ctx context.Context, accountID, name string) error {
+	return c.do(ctx, http.MethodDelete, streamsPath(accountID)+"/"+url.PathEscape(name), nil, nil, nil)
+}
+
+func (c *Client) ListConsumers(ctx context.Context, accountID, stream string) ([]Consumer, error) {
+	return listAll[Consumer](ctx, c, consumersPath(accountID, stream), nil)
+}
+
+func (c *Client) CreateConsumer(ctx context.Context, accountID, stream string, cfg *ConsumerConfig) (*Consumer, error) {
+	var out Consumer
+	if err := c.do(ctx, http.MethodPost, consumersPath(accountID, stream), nil, cfg, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) GetConsumer(ctx context.Context, accountID, stream, name string) (*Consumer, error) {
+	var out Consumer
+	if err := c.do(ctx, http.MethodGet, consumersPath(accountID, stream)+"/"+url.PathEscape(name), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) UpdateConsumer(ctx context.Context, accountID, stream string, cfg *ConsumerConfig, revision string) (*Consumer, error) {
+	var out Consumer
+	if err := c.do(ctx, http.MethodPut, consumersPath(accountID, stream)+"/"+url.PathEscape(cfg.Durable), nil, ifMatch{cfg, revision}, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) DeleteConsumer(ctx context.Context, accountID, stream, name string) error {
+	return c.do(ctx, http.MethodDelete, consumersPath(accountID, stream)+"/"+url.PathEscape(name), nil, nil, nil)
+}
+
+func (c *Client) ListKVBuckets(ctx context.Context, accountID string) ([]KVBucket, error) {
+	return listAll[KVBucket](ctx, c, kvBucketsPath(accountID), nil)
+}
+
+func (c *Client) CreateKVBucket(ctx context.Context, accountID string, cfg *KVBucketConfig) (*KVBucket, error) {
+	var out KVBucket
+	if err := c.do(ctx, http.MethodPost, kvBucketsPath(accountID), nil, cfg, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) GetKVBucket(ctx context.Context, accountID, bucket string) (*KVBucket, error) {
+	var out KVBucket
+	if err := c.do(ctx, http.MethodGet, kvBucketsPath(accountID)+"/"+url.PathEscape(bucket), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) UpdateKVBucket(ctx context.Context, accountID string, cfg *KVBucketConfig, revision string) (*KVBucket, error) {
+	var out KVBucket
+	if err := c.do(ctx, http.MethodPut, kvBucketsPath(accountID)+"/"+url.PathEscape(cfg.Bucket), nil, ifMatch{cfg, revision}, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) DeleteKVBucket(ctx context.Context, accountID, bucket string) error {
+	return c.do(ctx, http.MethodDelete, kvBucketsPath(accountID)+"/"+url.PathEscape(bucket), nil, nil, nil)
+}
+
+func (c *Client) ListObjectBuckets(ctx context.Context, accountID string) ([]ObjectBucket, error) {
+	return listAll[ObjectBucket](ctx, c, objectBucketsPath(accountID), nil)
+}
+
+func (c *Client) CreateObjectBucket(ctx context.Context, accountID string, cfg *ObjectBucketConfig) (*ObjectBucket, error) {
+	var out ObjectBucket
+	if err := c.do(ctx, http.MethodPost, objectBucketsPath(accountID), nil, cfg, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) GetObjectBucket(ctx context.Context, accountID, bucket string) (*ObjectBucket, error) {
+	var out ObjectBucket
+	if err := c.do(ctx, http.MethodGet, objectBucketsPath(accountID)+"/"+url.PathEscape(bucket), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) UpdateObjectBucket(ctx context.Context, accountID string, cfg *ObjectBucketConfig, revision string) (*ObjectBucket, error) {
+	var out ObjectBucket
+	if err := c.do(ctx, http.MethodPut, objectBucketsPath(accountID)+"/"+url.PathEscape(cfg.Bucket), nil, ifMatch{cfg, revision}, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) DeleteObjectBucket(ctx context.Context, accountID, bucket string) error {
+	return c.do(ctx, http.MethodDelete, objectBucketsPath(accountID)+"/"+url.PathEscape(bucket), nil, nil, nil)
+}
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// NatsUser is a NATS user issued under an account.
+type NatsUser struct {
+	ID        string   `json:"id"`
+	AccountID string   `json:"account_id"`
+	Name      string   `json:"name"`
+	PublicKey string   `json:"user_public_key"`
+	Tags      []string `json:"tags,omitempty"`
+
+	revisioned
+}
+
+// NatsUserCreateRequest creates a NATS user in an account. When PublicKey is
+// empty the control plane generates the user's NKey.
+type NatsUserCreateRequest struct {
+	Name      string `json:"name"`
+	PublicKey string `json:"user_public_key,omitempty"`
+}
+
+// NatsUserUpdateRequest updates mutable NATS user fields.
+type NatsUserUpdateRequest struct {
+	Name string `json:"name,omitempty"`
+}
+
+// managed returns the fields synadia_nats_user manages, which are what its
+// updates compare against when they conflict.
+func (u *NatsUser) managed() NatsUserCreateRequest {
+	return NatsUserCreateRequest{Name: u.Name, PublicKey: u.PublicKey}
+}
+
+func (c *Client) ListNatsUsers(ctx context.Context, accountID string, filter ListFilter) ([]NatsUser, error) {
+	path := "/accounts/" + url.PathEscape(accountID) + "/nats-users"
+	return listAll[NatsUser](ctx, c, path, filter.query())
+}
+
+// ListTeamNatsUsers lists the NATS users of every account owned by a team.
+func (c *Client) ListTeamNatsUsers(ctx context.Context, teamID string, filter ListFilter) ([]NatsUser, error) {
+	path := "/teams/" + url.PathEscape(teamID) + "/nats-users"
+	return listAll[NatsUser](ctx, c, path, filter.query())
+}
+
+// CreateNatsUser, UpdateNatsUser and DeleteNatsUser re-sign the account JWT
+// and are serialized per account.
+func (c *Client) CreateNatsUser(ctx context.Context, accountID string, req *NatsUserCreateRequest) (*NatsUser, error) {
+	unlock, err := c.lockAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	var out NatsUser
+	path := "/accounts/" + url.PathEscape(accountID) + "/nats-users"
+	if err := c.do(ctx, http.MethodPost, path, nil, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) GetNatsUser(ctx context.Context, id string) (*NatsUser, error) {
+	var out NatsUser
+	if err := c.do(ctx, http.MethodGet, "/nats-users/"+url.PathEscape(id), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) UpdateNatsUser(ctx context.Context, accountID, id string, req *NatsUserUpdateRequest, revision string) (*NatsUser, error) {
+	unlock, err := c.lockAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	var out NatsUser
+	if err := c.do(ctx, http.MethodPatch, "/nats-users/"+url.PathEscape(id), nil, ifMatch{req, revision}, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) DeleteNatsUser(ctx context.Context, accountID, id string) error {
+	unlock, err := c.lockAccount(ctx, accountID)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	return c.do(ctx, http.MethodDelete, "/nats-users/"+url.PathEscape(id), nil, nil, nil)
+}
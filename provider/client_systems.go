@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// System is a NATS deployment (cloud or self-hosted) owned by a team.
+type System struct {
+	ID     string `json:"id"`
+	TeamID string `json:"team_id"`
+	Name   string `json:"name"`
+}
+
+func (c *Client) ListTeamSystems(ctx context.Context, teamID string) ([]System, error) {
+	path := "/teams/" + url.PathEscape(teamID) + "/systems"
+	return listAll[System](ctx, c, path, nil)
+}
+
+func (c *Client) GetSystem(ctx context.Context, id string) (*System, error) {
+	var out System
+	if err := c.do(ctx, http.MethodGet, "/systems/"+url.PathEscape(id), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
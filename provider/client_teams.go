@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+)
+
+// Team groups systems and app users within an organization.
+type Team struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// ListTeams lists the teams the API token has access to.
+func (c *Client) ListTeams(ctx context.Context, filter ListFilter) ([]Team, error) {
+	return listAll[Team](ctx, c, "/teams", filter.query())
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &ConsumerListResource{}
+var _ list.ListResourceWithConfigure = &ConsumerListResource{}
+
+func NewConsumerListResource() list.ListResource {
+	return &ConsumerListResource{}
+}
+
+// ConsumerListResource defines the list resource implementation.
+type ConsumerListResource struct {
+	client *Client
+}
+
+// ConsumerListResourceModel describes the list resource config data model.
+type ConsumerListResourceModel struct {
+	AccountId  types.String `tfsdk:"account_id"`
+	StreamName types.String `tfsdk:"stream_name"`
+	NamePrefix types.String `tfsdk:"name_prefix"`
+}
+
+func (r *ConsumerListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_consumer"
+}
+
+func (r *ConsumerListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the durable JetStream consumers of an account, optionally limited to a single stream. " +
+			"Ephemeral consumers are skipped because they cannot be managed.",
+
+		Attributes: map[string]listschema.Attribute{
+			"account_id": listschema.StringAttribute{
+				MarkdownDescription: "Account to list consumers in",
+				Required:            true,
+			},
+			"stream_name": listschema.StringAttribute{
+				MarkdownDescription: "Only list consumers of this stream. Defaults to every stream in the account.",
+				Optional:            true,
+			},
+			"name_prefix": namePrefixAttribute("consumers"),
+		},
+	}
+}
+
+func (r *ConsumerListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ConsumerListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config ConsumerListResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	accountID := config.AccountId.ValueString()
+
+	streamNames := []string{config.StreamName.ValueString()}
+	if config.StreamName.IsNull() {
+		streams, err := r.client.ListStreams(ctx, accountID)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to list streams, got error: %s", err))
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+
+		streamNames = streamNames[:0]
+		for _, s := range streams {
+			if !IsBucketStream(s.Config.Name) {
+				streamNames = append(streamNames, s.Config.Name)
+			}
+		}
+	}
+
+	filter := newListFilter(config.NamePrefix, types.StringNull())
+	var matched []Consumer
+	for _, name := range streamNames {
+		consumers, err := r.client.ListConsumers(ctx, accountID, name)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to list consumers of stream %s, got error: %s", name, err))
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+
+		for _, c := range consumers {
+			if c.Config.Durable != "" && filter.matches(c.Name, nil) {
+				matched = append(matched, c)
+			}
+		}
+	}
+
+	stream.Results = listResults(ctx, req, matched, func(c *Consumer, result *list.ListResult) {
+		var data ConsumerResourceModel
+		data.fromAPI(accountID, c)
+		result.DisplayName = c.StreamName + "/" + c.Name
+		result.Diagnostics.Append(result.Identity.Set(ctx, data.identity())...)
+		if req.IncludeResource {
+			result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+		}
+	})
+}
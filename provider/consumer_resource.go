@@ -0,0 +1,397 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ConsumerResource{}
+var _ resource.ResourceWithImportState = &ConsumerResource{}
+var _ resource.ResourceWithIdentity = &ConsumerResource{}
+
+func NewConsumerResource() resource.Resource {
+	return &ConsumerResource{}
+}
+
+// ConsumerResource defines the resource implementation.
+type ConsumerResource struct {
+	client *Client
+}
+
+// ConsumerResourceModel describes the resource data model.
+type ConsumerResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	AccountId      types.String `tfsdk:"account_id"`
+	StreamName     types.String `tfsdk:"stream_name"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	FilterSubject  types.String `tfsdk:"filter_subject"`
+	DeliverPolicy  types.String `tfsdk:"deliver_policy"`
+	AckPolicy      types.String `tfsdk:"ack_policy"`
+	DeliverSubject types.String `tfsdk:"deliver_subject"`
+	MaxDeliver     types.Int64  `tfsdk:"max_deliver"`
+	Type           types.String `tfsdk:"type"`
+}
+
+// consumerAPIFields maps consumer request fields to schema attributes.
+var consumerAPIFields = apiFieldPaths{
+	"durable_name":    path.Root("name"),
+	"description":     path.Root("description"),
+	"filter_subject":  path.Root("filter_subject"),
+	"deliver_policy":  path.Root("deliver_policy"),
+	"ack_policy":      path.Root("ack_policy"),
+	"deliver_subject": path.Root("deliver_subject"),
+	"max_deliver":     path.Root("max_deliver"),
+}
+
+// ConsumerResourceIdentityModel describes the resource identity data model.
+type ConsumerResourceIdentityModel struct {
+	AccountId  types.String `tfsdk:"account_id"`
+	StreamName types.String `tfsdk:"stream_name"`
+	Name       types.String `tfsdk:"name"`
+}
+
+// Consumer types derived from whether a deliver subject is configured.
+const (
+	consumerTypePull = "pull"
+	consumerTypePush = "push"
+)
+
+func consumerType(cfg ConsumerConfig) string {
+	if cfg.DeliverSubject != "" {
+		return consumerTypePush
+	}
+	return consumerTypePull
+}
+
+func (r *ConsumerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_consumer"
+}
+
+func (r *ConsumerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	replace := []planmodifier.String{
+		stringplanmodifier.RequiresReplace(),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a durable JetStream consumer. Setting `deliver_subject` makes it a push consumer, " +
+			"otherwise it is a pull consumer. Import with `<account_id>/<stream_name>/<consumer_name>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Consumer identifier in the form `<account_id>/<stream_name>/<consumer_name>`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "Account the stream belongs to",
+				Required:            true,
+				PlanModifiers:       replace,
+			},
+			"stream_name": schema.StringAttribute{
+				MarkdownDescription: "Stream the consumer reads from",
+				Required:            true,
+				PlanModifiers:       replace,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Durable consumer name",
+				Required:            true,
+				PlanModifiers:       replace,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Consumer description",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"filter_subject": schema.StringAttribute{
+				MarkdownDescription: "Only deliver messages matching this subject",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"deliver_policy": schema.StringAttribute{
+				MarkdownDescription: "Where to start delivering: `all`, `last`, `new` or `last_per_subject`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("all"),
+				PlanModifiers:       replace,
+				Validators: []validator.String{
+					stringvalidator.OneOf("all", "last", "new", "last_per_subject"),
+				},
+			},
+			"ack_policy": schema.StringAttribute{
+				MarkdownDescription: "Acknowledgement policy: `explicit`, `all` or `none`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("explicit"),
+				PlanModifiers:       replace,
+				Validators: []validator.String{
+					stringvalidator.OneOf("explicit", "all", "none"),
+				},
+			},
+			"deliver_subject": schema.StringAttribute{
+				MarkdownDescription: "Subject push consumers deliver to. Leave unset for a pull consumer.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				PlanModifiers:       replace,
+			},
+			"max_deliver": schema.Int64Attribute{
+				MarkdownDescription: "Maximum delivery attempts per message, `-1` for unlimited",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Consumer type, `pull` or `push`",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ConsumerResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"account_id": identityschema.StringAttribute{
+				Description:       "Account the stream belongs to",
+				RequiredForImport: true,
+			},
+			"stream_name": identityschema.StringAttribute{
+				Description:       "Stream the consumer reads from",
+				RequiredForImport: true,
+			},
+			"name": identityschema.StringAttribute{
+				Description:       "Durable consumer name",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *ConsumerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ConsumerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := r.client.startOperation(ctx, "synadia_consumer", "create")
+	defer func() { span.end(resp.Diagnostics) }()
+
+	var data ConsumerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	span.setAccount(data.AccountId.ValueString())
+
+	consumer, err := r.client.CreateConsumer(ctx, data.AccountId.ValueString(), data.StreamName.ValueString(), data.toAPI())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "create consumer", err, consumerAPIFields)
+		return
+	}
+
+	tflog.Trace(ctx, "created a consumer", map[string]any{"stream": data.StreamName.ValueString(), "name": consumer.Name})
+
+	data.fromAPI(data.AccountId.ValueString(), consumer)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+	resp.Diagnostics.Append(saveRevision(ctx, resp.Private, consumer.Revision, consumer.Config)...)
+}
+
+func (r *ConsumerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := r.client.startOperation(ctx, "synadia_consumer", "read")
+	defer func() { span.end(resp.Diagnostics) }()
+
+	var data ConsumerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if checkSDKv2State(&resp.Diagnostics, "synadia_consumer", data.AccountId) {
+		return
+	}
+
+	span.setAccount(data.AccountId.ValueString())
+
+	consumer, err := r.client.GetConsumer(ctx, data.AccountId.ValueString(), data.StreamName.ValueString(), data.Name.ValueString())
+	if IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read consumer, got error: %s", err))
+		return
+	}
+
+	data.fromAPI(data.AccountId.ValueString(), consumer)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+	resp.Diagnostics.Append(saveRevision(ctx, resp.Private, consumer.Revision, consumer.Config)...)
+}
+
+func (r *ConsumerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := r.client.startOperation(ctx, "synadia_consumer", "update")
+	defer func() { span.end(resp.Diagnostics) }()
+
+	var data ConsumerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	span.setAccount(data.AccountId.ValueString())
+
+	observed, diags := loadRevision(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	consumer, err := r.client.UpdateConsumer(ctx, data.AccountId.ValueString(), data.StreamName.ValueString(), data.toAPI(), observed.Revision)
+	if IsConflict(err) {
+		current, getErr := r.client.GetConsumer(ctx, data.AccountId.ValueString(), data.StreamName.ValueString(), data.Name.ValueString())
+		if getErr != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read changed consumer, got error: %s", getErr))
+			return
+		}
+		addConflictError(&resp.Diagnostics, "consumer", observed, current.Config)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "update consumer", err, consumerAPIFields)
+		return
+	}
+
+	data.fromAPI(data.AccountId.ValueString(), consumer)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+	resp.Diagnostics.Append(saveRevision(ctx, resp.Private, consumer.Revision, consumer.Config)...)
+}
+
+func (r *ConsumerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := r.client.startOperation(ctx, "synadia_consumer", "delete")
+	defer func() { span.end(resp.Diagnostics) }()
+
+	var data ConsumerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	span.setAccount(data.AccountId.ValueString())
+
+	err := r.client.DeleteConsumer(ctx, data.AccountId.ValueString(), data.StreamName.ValueString(), data.Name.ValueString())
+	if err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete consumer, got error: %s", err))
+		return
+	}
+}
+
+// ImportState accepts either "<account_id>/<stream_name>/<consumer_name>" or an import block identity.
+func (r *ConsumerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := req.ID
+	if id == "" {
+		var identity ConsumerResourceIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		id = compositeID(identity.AccountId.ValueString(), identity.StreamName.ValueString(), identity.Name.ValueString())
+	}
+
+	parts, err := parseCompositeID(id, 3, "account_id/stream_name/consumer_name")
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("stream_name"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[2])...)
+}
+
+func (m *ConsumerResourceModel) toAPI() *ConsumerConfig {
+	return &ConsumerConfig{
+		Durable:        m.Name.ValueString(),
+		Description:    m.Description.ValueString(),
+		FilterSubject:  m.FilterSubject.ValueString(),
+		DeliverPolicy:  m.DeliverPolicy.ValueString(),
+		AckPolicy:      m.AckPolicy.ValueString(),
+		DeliverSubject: m.DeliverSubject.ValueString(),
+		MaxDeliver:     m.MaxDeliver.ValueInt64(),
+	}
+}
+
+func (m *ConsumerResourceModel) fromAPI(accountID string, consumer *Consumer) {
+	cfg := consumer.Config
+
+	m.Id = types.StringValue(compositeID(accountID, consumer.StreamName, consumer.Name))
+	m.AccountId = types.StringValue(accountID)
+	m.StreamName = types.StringValue(consumer.StreamName)
+	m.Name = types.StringValue(consumer.Name)
+	m.Description = types.StringValue(cfg.Description)
+	m.FilterSubject = types.StringValue(cfg.FilterSubject)
+	m.DeliverPolicy = types.StringValue(cfg.DeliverPolicy)
+	m.AckPolicy = types.StringValue(cfg.AckPolicy)
+	m.DeliverSubject = types.StringValue(cfg.DeliverSubject)
+	m.MaxDeliver = types.Int64Value(cfg.MaxDeliver)
+	m.Type = types.StringValue(consumerType(cfg))
+}
+
+func (m *ConsumerResourceModel) identity() *ConsumerResourceIdentityModel {
+	return &ConsumerResourceIdentityModel{
+		AccountId:  m.AccountId,
+		StreamName: m.StreamName,
+		Name:       m.Name,
+	}
+}
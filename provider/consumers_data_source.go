@@ -0,0 +1,265 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ConsumersDataSource{}
+
+// NewConsumersDataSource returns the data source listing the consumers of a
+// stream.
+func NewConsumersDataSource() datasource.DataSource {
+	return &ConsumersDataSource{}
+}
+
+// NewMirrorConsumersDataSource returns the data source listing the consumers
+// other streams created on a stream to mirror it.
+func NewMirrorConsumersDataSource() datasource.DataSource {
+	return &ConsumersDataSource{mirrors: true}
+}
+
+// ConsumersDataSource defines the data source implementation. With mirrors
+// set it only reports the consumers of mirroring streams.
+type ConsumersDataSource struct {
+	client  *Client
+	mirrors bool
+}
+
+// ConsumersDataSourceModel describes the data source data model.
+type ConsumersDataSourceModel struct {
+	AccountId   types.String           `tfsdk:"account_id"`
+	StreamName  types.String           `tfsdk:"stream_name"`
+	DurableName types.String           `tfsdk:"durable_name"`
+	Type        types.String           `tfsdk:"type"`
+	Consumers   []ConsumerSummaryModel `tfsdk:"consumers"`
+}
+
+// ConsumerSummaryModel describes a single consumer entry.
+type ConsumerSummaryModel struct {
+	Id             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	StreamName     types.String `tfsdk:"stream_name"`
+	Description    types.String `tfsdk:"description"`
+	Type           types.String `tfsdk:"type"`
+	FilterSubject  types.String `tfsdk:"filter_subject"`
+	DeliverPolicy  types.String `tfsdk:"deliver_policy"`
+	AckPolicy      types.String `tfsdk:"ack_policy"`
+	DeliverSubject types.String `tfsdk:"deliver_subject"`
+	MaxDeliver     types.Int64  `tfsdk:"max_deliver"`
+	NumPending     types.Int64  `tfsdk:"num_pending"`
+	NumAckPending  types.Int64  `tfsdk:"num_ack_pending"`
+	NumRedelivered types.Int64  `tfsdk:"num_redelivered"`
+	LastActive     types.String `tfsdk:"last_active"`
+}
+
+func consumerSummaryFromAPI(accountID string, c *Consumer) ConsumerSummaryModel {
+	cfg := c.Config
+	lastActive := types.StringNull()
+	if c.Delivered != nil && c.Delivered.LastActive != nil {
+		lastActive = types.StringValue(c.Delivered.LastActive.UTC().Format(time.RFC3339))
+	}
+
+	return ConsumerSummaryModel{
+		Id:             types.StringValue(compositeID(accountID, c.StreamName, c.Name)),
+		Name:           types.StringValue(c.Name),
+		StreamName:     types.StringValue(c.StreamName),
+		Description:    types.StringValue(cfg.Description),
+		Type:           types.StringValue(consumerType(cfg)),
+		FilterSubject:  types.StringValue(cfg.FilterSubject),
+		DeliverPolicy:  types.StringValue(cfg.DeliverPolicy),
+		AckPolicy:      types.StringValue(cfg.AckPolicy),
+		DeliverSubject: types.StringValue(cfg.DeliverSubject),
+		MaxDeliver:     types.Int64Value(cfg.MaxDeliver),
+		NumPending:     types.Int64Value(c.NumPending),
+		NumAckPending:  types.Int64Value(c.NumAckPending),
+		NumRedelivered: types.Int64Value(c.NumRedelivered),
+		LastActive:     lastActive,
+	}
+}
+
+// consumerMatches applies the durable_name and type filters. Null filters
+// match everything.
+func consumerMatches(c *Consumer, durableName, consumerTypeFilter types.String) bool {
+	if !durableName.IsNull() && c.Config.Durable != durableName.ValueString() {
+		return false
+	}
+	return consumerTypeFilter.IsNull() || consumerType(c.Config) == consumerTypeFilter.ValueString()
+}
+
+// consumerListAttributes returns the filter and result attributes shared by
+// the consumer data sources.
+func consumerListAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"durable_name": schema.StringAttribute{
+			MarkdownDescription: "Only return the durable consumer with this name",
+			Optional:            true,
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: "Only return consumers of this type: `pull` or `push`",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(consumerTypePull, consumerTypePush),
+			},
+		},
+		"consumers": schema.ListNestedAttribute{
+			MarkdownDescription: "Matching consumers, ordered as returned by the control plane",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						MarkdownDescription: "Consumer identifier in the form `<account_id>/<stream_name>/<consumer_name>`",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Consumer name",
+						Computed:            true,
+					},
+					"stream_name": schema.StringAttribute{
+						MarkdownDescription: "Stream the consumer reads from",
+						Computed:            true,
+					},
+					"description": schema.StringAttribute{
+						MarkdownDescription: "Consumer description",
+						Computed:            true,
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Consumer type, `pull` or `push`",
+						Computed:            true,
+					},
+					"filter_subject": schema.StringAttribute{
+						MarkdownDescription: "Subject filter within the stream",
+						Computed:            true,
+					},
+					"deliver_policy": schema.StringAttribute{
+						MarkdownDescription: "Deliver policy",
+						Computed:            true,
+					},
+					"ack_policy": schema.StringAttribute{
+						MarkdownDescription: "Ack policy",
+						Computed:            true,
+					},
+					"deliver_subject": schema.StringAttribute{
+						MarkdownDescription: "Subject push consumers deliver to",
+						Computed:            true,
+					},
+					"max_deliver": schema.Int64Attribute{
+						MarkdownDescription: "Maximum delivery attempts",
+						Computed:            true,
+					},
+					"num_pending": schema.Int64Attribute{
+						MarkdownDescription: "Number of messages not yet delivered",
+						Computed:            true,
+					},
+					"num_ack_pending": schema.Int64Attribute{
+						MarkdownDescription: "Number of messages delivered but not yet acknowledged",
+						Computed:            true,
+					},
+					"num_redelivered": schema.Int64Attribute{
+						MarkdownDescription: "Number of messages delivered more than once",
+						Computed:            true,
+					},
+					"last_active": schema.StringAttribute{
+						MarkdownDescription: "RFC 3339 time of the last delivery, unset if nothing was delivered yet",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ConsumersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	if d.mirrors {
+		resp.TypeName = req.ProviderTypeName + "_mirror_consumers"
+		return
+	}
+	resp.TypeName = req.ProviderTypeName + "_consumers"
+}
+
+func (d *ConsumersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := map[string]schema.Attribute{
+		"account_id": schema.StringAttribute{
+			MarkdownDescription: "Account the stream belongs to",
+			Required:            true,
+		},
+		"stream_name": schema.StringAttribute{
+			MarkdownDescription: "Stream to list consumers of",
+			Required:            true,
+		},
+	}
+	maps.Copy(attrs, consumerListAttributes())
+
+	description := "Lists the consumers of a JetStream stream together with their runtime state."
+	if d.mirrors {
+		description = "Lists the consumers other streams created on a JetStream stream to mirror it, together with " +
+			"their runtime state."
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: description,
+
+		Attributes: attrs,
+	}
+}
+
+func (d *ConsumersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ConsumersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ConsumersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountID := data.AccountId.ValueString()
+
+	consumers, err := d.client.ListConsumers(ctx, accountID, data.StreamName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list consumers, got error: %s", err))
+		return
+	}
+
+	data.Consumers = []ConsumerSummaryModel{}
+	for i := range consumers {
+		c := &consumers[i]
+		if (d.mirrors && !c.isMirror()) || !consumerMatches(c, data.DurableName, data.Type) {
+			continue
+		}
+		data.Consumers = append(data.Consumers, consumerSummaryFromAPI(accountID, c))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bufio"
+	"cmp"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Environment variables read by the provider. See LoadCredentials for how they
+// rank against provider attributes and the credentials file.
+const (
+	envEndpoint        = "SYNADIA_API_ENDPOINT"
+	envToken           = "SYNADIA_API_TOKEN"
+	envProfile         = "SYNADIA_PROFILE"
+	envCredentialsFile = "SYNADIA_CREDENTIALS_FILE"
+)
+
+// defaultProfile is used when neither the profile attribute nor
+// SYNADIA_PROFILE names one.
+const defaultProfile = "default"
+
+// DefaultEndpoint is used when neither the endpoint attribute, the
+// SYNADIA_API_ENDPOINT environment variable nor the profile set one.
+const DefaultEndpoint = "https://api.synadia.cloud"
+
+// ErrMissingToken is returned by LoadCredentials when no source provides an
+// API token.
+var ErrMissingToken = errors.New("no API token configured")
+
+// Credentials are the control plane endpoint and the API token to
+// authenticate with.
+type Credentials struct {
+	Endpoint string
+	Token    string
+}
+
+// LoadCredentials resolves the endpoint and token. Explicit values (provider
+// attributes or command line flags) always win. A profile selected with
+// profileName or SYNADIA_PROFILE comes next, so that selecting it is not
+// silently undone by an ambient SYNADIA_API_TOKEN; the environment fills in
+// what it leaves unset. Without a selected profile the environment wins over
+// the "default" profile.
+func LoadCredentials(endpoint, token, profileName string) (Credentials, error) {
+	if profileName == "" {
+		profileName = os.Getenv(envProfile)
+	}
+	explicitProfile := profileName != ""
+	if !explicitProfile {
+		profileName = defaultProfile
+	}
+
+	prof, err := loadProfile(profileName, explicitProfile)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("reading profile %q: %w", profileName, err)
+	}
+
+	var creds Credentials
+	if explicitProfile {
+		creds = Credentials{
+			Endpoint: cmp.Or(endpoint, prof.Endpoint, os.Getenv(envEndpoint), DefaultEndpoint),
+			Token:    cmp.Or(token, prof.Token, os.Getenv(envToken)),
+		}
+	} else {
+		creds = Credentials{
+			Endpoint: cmp.Or(endpoint, os.Getenv(envEndpoint), prof.Endpoint, DefaultEndpoint),
+			Token:    cmp.Or(token, os.Getenv(envToken), prof.Token),
+		}
+	}
+
+	if creds.Token == "" {
+		return Credentials{}, ErrMissingToken
+	}
+	return creds, nil
+}
+
+// profile holds the settings a credentials file section may provide.
+type profile struct {
+	Endpoint string
+	Token    string
+}
+
+// credentialsFilePath returns SYNADIA_CREDENTIALS_FILE if set, otherwise
+// synadia/credentials under the user's config directory
+// (e.g. ~/.config/synadia/credentials on Linux).
+func credentialsFilePath() (string, error) {
+	if p := os.Getenv(envCredentialsFile); p != "" {
+		return p, nil
+	}
+
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "synadia", "credentials"), nil
+}
+
+// loadProfile reads the named profile from the credentials file. A missing
+// file or profile is only an error when the profile was asked for
+// explicitly; otherwise an empty profile is returned.
+func loadProfile(name string, explicit bool) (profile, error) {
+	path, err := credentialsFilePath()
+	if err != nil {
+		if explicit {
+			return profile{}, fmt.Errorf("locating credentials file: %w", err)
+		}
+		return profile{}, nil
+	}
+
+	profiles, err := parseCredentialsFile(path)
+	if errors.Is(err, fs.ErrNotExist) && !explicit {
+		return profile{}, nil
+	}
+	if err != nil {
+		return profile{}, err
+	}
+
+	p, ok := profiles[name]
+	if !ok && explicit {
+		return profile{}, fmt.Errorf("profile %q not found in %s", name, path)
+	}
+	return p, nil
+}
+
+// parseCredentialsFile parses an INI style file with one section per
+// profile:
+//
+//	[default]
+//	token = ...
+//
+//	[staging]
+//	endpoint = https://staging.example.com
+//	token    = ...
+//
+// Blank lines and lines starting with '#' or ';' are ignored.
+func parseCredentialsFile(path string) (map[string]profile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	profiles := map[string]profile{}
+	var section string
+
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			profiles[section] = profile{}
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok || section == "" {
+			return nil, fmt.Errorf("%s:%d: expected a [profile] header or key = value", path, n)
+		}
+
+		p := profiles[section]
+		switch strings.TrimSpace(key) {
+		case "endpoint":
+			p.Endpoint = strings.TrimSpace(value)
+		case "token":
+			p.Token = strings.TrimSpace(value)
+		default:
+			return nil, fmt.Errorf("%s:%d: unknown key %q", path, n, strings.TrimSpace(key))
+		}
+		profiles[section] = p
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	return profiles, nil
+}
@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testCredentialsFile = `# Synadia credentials
+[default]
+token = default-token
+
+; staging has its own endpoint
+[staging]
+endpoint = https://staging.example.com
+token    = staging-token
+
+[endpoint-only]
+endpoint = https://other.example.com
+`
+
+// setCredentialsEnv points the provider at a credentials file holding content
+// and clears every other variable LoadCredentials reads.
+func setCredentialsEnv(t *testing.T, content string) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "credentials")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv(envCredentialsFile, path)
+	t.Setenv(envEndpoint, "")
+	t.Setenv(envToken, "")
+	t.Setenv(envProfile, "")
+}
+
+func TestLoadCredentials(t *testing.T) {
+	tests := map[string]struct {
+		endpoint string
+		token    string
+		profile  string
+		env      map[string]string
+		want     Credentials
+	}{
+		"default profile": {
+			want: Credentials{Endpoint: DefaultEndpoint, Token: "default-token"},
+		},
+		"env over default profile": {
+			env:  map[string]string{envToken: "env-token", envEndpoint: "https://env.example.com"},
+			want: Credentials{Endpoint: "https://env.example.com", Token: "env-token"},
+		},
+		"attributes over env": {
+			endpoint: "https://attr.example.com",
+			token:    "attr-token",
+			env:      map[string]string{envToken: "env-token", envEndpoint: "https://env.example.com"},
+			want:     Credentials{Endpoint: "https://attr.example.com", Token: "attr-token"},
+		},
+		"selected profile": {
+			profile: "staging",
+			want:    Credentials{Endpoint: "https://staging.example.com", Token: "staging-token"},
+		},
+		"selected profile over env": {
+			profile: "staging",
+			env:     map[string]string{envToken: "env-token", envEndpoint: "https://env.example.com"},
+			want:    Credentials{Endpoint: "https://staging.example.com", Token: "staging-token"},
+		},
+		"profile selected by env over env token": {
+			env:  map[string]string{envProfile: "staging", envToken: "env-token"},
+			want: Credentials{Endpoint: "https://staging.example.com", Token: "staging-token"},
+		},
+		"profile attribute over profile env": {
+			profile: "staging",
+			env:     map[string]string{envProfile: "default"},
+			want:    Credentials{Endpoint: "https://staging.example.com", Token: "staging-token"},
+		},
+		"env fills in what the profile leaves out": {
+			profile: "endpoint-only",
+			env:     map[string]string{envToken: "env-token"},
+			want:    Credentials{Endpoint: "https://other.example.com", Token: "env-token"},
+		},
+		"attributes over selected profile": {
+			token:   "attr-token",
+			profile: "staging",
+			want:    Credentials{Endpoint: "https://staging.example.com", Token: "attr-token"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			setCredentialsEnv(t, testCredentialsFile)
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			got, err := LoadCredentials(tt.endpoint, tt.token, tt.profile)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadCredentials_Errors(t *testing.T) {
+	t.Run("missing token", func(t *testing.T) {
+		setCredentialsEnv(t, "[default]\nendpoint = https://example.com\n")
+
+		_, err := LoadCredentials("", "", "")
+		if !errors.Is(err, ErrMissingToken) {
+			t.Fatalf("got error %v, want ErrMissingToken", err)
+		}
+	})
+
+	t.Run("unknown profile", func(t *testing.T) {
+		setCredentialsEnv(t, testCredentialsFile)
+
+		_, err := LoadCredentials("", "", "prod")
+		if err == nil || !strings.Contains(err.Error(), `profile "prod" not found`) {
+			t.Fatalf("got error %v, want profile not found", err)
+		}
+	})
+
+	t.Run("missing file without a selected profile", func(t *testing.T) {
+		setCredentialsEnv(t, "")
+		t.Setenv(envCredentialsFile, filepath.Join(t.TempDir(), "missing"))
+		t.Setenv(envToken, "env-token")
+
+		got, err := LoadCredentials("", "", "")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if got.Token != "env-token" {
+			t.Errorf("got token %q, want env-token", got.Token)
+		}
+	})
+
+	t.Run("missing file with a selected profile", func(t *testing.T) {
+		setCredentialsEnv(t, "")
+		t.Setenv(envCredentialsFile, filepath.Join(t.TempDir(), "missing"))
+		t.Setenv(envToken, "env-token")
+
+		if _, err := LoadCredentials("", "", "staging"); err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+}
+
+func TestParseCredentialsFile(t *testing.T) {
+	tests := map[string]struct {
+		content string
+		want    map[string]profile
+		wantErr string
+	}{
+		"profiles": {
+			content: testCredentialsFile,
+			want: map[string]profile{
+				"default":       {Token: "default-token"},
+				"staging":       {Endpoint: "https://staging.example.com", Token: "staging-token"},
+				"endpoint-only": {Endpoint: "https://other.example.com"},
+			},
+		},
+		"empty section": {
+			content: "[default]\n",
+			want:    map[string]profile{"default": {}},
+		},
+		"value containing equals sign": {
+			content: "[default]\ntoken = abc==\n",
+			want:    map[string]profile{"default": {Token: "abc=="}},
+		},
+		"key before section": {
+			content: "token = abc\n",
+			wantErr: ":1: expected a [profile] header or key = value",
+		},
+		"line without equals sign": {
+			content: "[default]\ntoken\n",
+			wantErr: ":2: expected a [profile] header or key = value",
+		},
+		"unknown key": {
+			content: "[default]\nregion = eu\n",
+			wantErr: `:2: unknown key "region"`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "credentials")
+			if err := os.WriteFile(path, []byte(tt.content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := parseCredentialsFile(path)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("got error %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d profiles %+v, want %+v", len(got), got, tt.want)
+			}
+			for name, want := range tt.want {
+				if got[name] != want {
+					t.Errorf("profile %q: got %+v, want %+v", name, got[name], want)
+				}
+			}
+		})
+	}
+}
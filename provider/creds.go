@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/nats-io/jwt/v2"
+	"github.com/nats-io/nkeys"
+)
+
+// credsBlockRE matches one decorated block of a .creds file, e.g.
+//
+//	-----BEGIN NATS USER JWT-----
+//	eyJ0...
+//	------END NATS USER JWT------
+//
+// capturing the block kind and its content.
+var credsBlockRE = regexp.MustCompile(`(?m)^\s*-{3,}BEGIN ([A-Z ]+)-{3,}\s*\n\s*(\S+)\s*\n\s*-{3,}END ([A-Z ]+)-{3,}\s*$`)
+
+// Block kinds of a user .creds file, in the order they must appear.
+const (
+	credsJWTBlock  = "NATS USER JWT"
+	credsSeedBlock = "USER NKEY SEED"
+)
+
+// formatCreds returns the decorated .creds file for a user JWT and the user's
+// nkey seed. The seed must belong to the JWT's subject.
+func formatCreds(userJWT, seed string) (string, error) {
+	userJWT = strings.TrimSpace(userJWT)
+	seed = strings.TrimSpace(seed)
+
+	if _, err := checkCredsPair(userJWT, seed); err != nil {
+		return "", err
+	}
+
+	creds, err := jwt.FormatUserConfig(userJWT, []byte(seed))
+	if err != nil {
+		return "", err
+	}
+	return string(creds), nil
+}
+
+// parseCreds extracts the user JWT, seed and public key from a decorated
+// .creds file. Unlike the NATS client libraries it does not fall back to
+// undecorated input: both blocks must be present, in order.
+func parseCreds(creds string) (userJWT, seed, publicKey string, err error) {
+	blocks := credsBlockRE.FindAllStringSubmatch(creds, -1)
+	if len(blocks) != 2 {
+		return "", "", "", fmt.Errorf("expected a %q block followed by a %q block, found %d blocks", credsJWTBlock, credsSeedBlock, len(blocks))
+	}
+
+	for i, want := range []string{credsJWTBlock, credsSeedBlock} {
+		begin, end := strings.TrimSpace(blocks[i][1]), strings.TrimSpace(blocks[i][3])
+		if begin != want || end != want {
+			return "", "", "", fmt.Errorf("block %d: expected %q, got BEGIN %q / END %q", i+1, want, begin, end)
+		}
+	}
+
+	userJWT, seed = blocks[0][2], blocks[1][2]
+	publicKey, err = checkCredsPair(userJWT, seed)
+	if err != nil {
+		return "", "", "", err
+	}
+	return userJWT, seed, publicKey, nil
+}
+
+// checkCredsPair verifies that userJWT is a validly signed user JWT and that
+// seed is the user nkey seed for its subject, and returns the user's public
+// key.
+func checkCredsPair(userJWT, seed string) (string, error) {
+	claims, err := jwt.DecodeUserClaims(userJWT)
+	if err != nil {
+		return "", fmt.Errorf("invalid user JWT: %w", err)
+	}
+
+	kp, err := nkeys.FromSeed([]byte(seed))
+	if err != nil {
+		return "", fmt.Errorf("invalid nkey seed: %w", err)
+	}
+	if !strings.HasPrefix(seed, "SU") {
+		return "", errors.New("nkey seed is not a user seed")
+	}
+
+	pub, err := kp.PublicKey()
+	if err != nil {
+		return "", fmt.Errorf("invalid nkey seed: %w", err)
+	}
+	if pub != claims.Subject {
+		return "", fmt.Errorf("nkey seed is for %s but the JWT is for %s", pub, claims.Subject)
+	}
+	return pub, nil
+}
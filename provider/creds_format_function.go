@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var (
+	_ function.Function = CredsFormatFunction{}
+)
+
+func NewCredsFormatFunction() function.Function {
+	return CredsFormatFunction{}
+}
+
+type CredsFormatFunction struct{}
+
+func (r CredsFormatFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "creds_format"
+}
+
+func (r CredsFormatFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build a NATS .creds file",
+		MarkdownDescription: "Returns the decorated `.creds` file content for a user JWT and the user's nkey seed, " +
+			"exactly as written by `nsc` and read by the NATS clients. Fails if the JWT is not a validly signed user " +
+			"JWT or the seed does not belong to its subject. The result contains the seed; wrap it in `sensitive()`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "jwt",
+				MarkdownDescription: "Encoded user JWT",
+			},
+			function.StringParameter{
+				Name:                "seed",
+				MarkdownDescription: "User nkey seed (`SU...`)",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (r CredsFormatFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var userJWT, seed string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &userJWT, &seed))
+
+	if resp.Error != nil {
+		return
+	}
+
+	creds, err := formatCreds(userJWT, seed)
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Unable to build creds: %s", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, creds))
+}
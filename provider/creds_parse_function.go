@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ function.Function = CredsParseFunction{}
+)
+
+func NewCredsParseFunction() function.Function {
+	return CredsParseFunction{}
+}
+
+type CredsParseFunction struct{}
+
+// credsModel is the object returned by creds_parse.
+type credsModel struct {
+	JWT       string `tfsdk:"jwt"`
+	Seed      string `tfsdk:"seed"`
+	PublicKey string `tfsdk:"public_key"`
+}
+
+func (r CredsParseFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "creds_parse"
+}
+
+func (r CredsParseFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse a NATS .creds file",
+		MarkdownDescription: "Splits decorated `.creds` file content into the user `jwt`, its nkey `seed` and the " +
+			"user's `public_key`. Fails unless the content has a `NATS USER JWT` block followed by a " +
+			"`USER NKEY SEED` block, the JWT is validly signed and the seed belongs to its subject.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "creds",
+				MarkdownDescription: "Content of a `.creds` file",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"jwt":        types.StringType,
+				"seed":       types.StringType,
+				"public_key": types.StringType,
+			},
+		},
+	}
+}
+
+func (r CredsParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var creds string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &creds))
+
+	if resp.Error != nil {
+		return
+	}
+
+	userJWT, seed, publicKey, err := parseCreds(creds)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid creds: %s", err))
+		return
+	}
+
+	data := credsModel{
+		JWT:       userJWT,
+		Seed:      seed,
+		PublicKey: publicKey,
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, data))
+}
@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nats-io/jwt/v2"
+	"github.com/nats-io/nkeys"
+)
+
+// newTestUser returns a user JWT signed by a fresh account key, and the
+// user's seed and public key.
+func newTestUser(t *testing.T) (userJWT, seed, publicKey string) {
+	t.Helper()
+
+	account, err := nkeys.CreateAccount()
+	if err != nil {
+		t.Fatal(err)
+	}
+	user, err := nkeys.CreateUser()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	publicKey, err = user.PublicKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	rawSeed, err := user.Seed()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	claims := jwt.NewUserClaims(publicKey)
+	claims.Name = "alice"
+	userJWT, err = claims.Encode(account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return userJWT, string(rawSeed), publicKey
+}
+
+func credsBlock(kind, content string) string {
+	return "-----BEGIN " + kind + "-----\n" + content + "\n------END " + kind + "------\n"
+}
+
+func TestFormatCreds_RoundTrip(t *testing.T) {
+	userJWT, seed, publicKey := newTestUser(t)
+
+	creds, err := formatCreds(userJWT+"\n", " "+seed)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	gotJWT, gotSeed, gotPublicKey, err := parseCreds(creds)
+	if err != nil {
+		t.Fatalf("unexpected error parsing %q: %s", creds, err)
+	}
+	if gotJWT != userJWT {
+		t.Errorf("got JWT %q, want %q", gotJWT, userJWT)
+	}
+	if gotSeed != seed {
+		t.Errorf("got seed %q, want %q", gotSeed, seed)
+	}
+	if gotPublicKey != publicKey {
+		t.Errorf("got public key %q, want %q", gotPublicKey, publicKey)
+	}
+}
+
+func TestFormatCreds_Errors(t *testing.T) {
+	userJWT, _, _ := newTestUser(t)
+	_, otherSeed, _ := newTestUser(t)
+
+	account, err := nkeys.CreateAccount()
+	if err != nil {
+		t.Fatal(err)
+	}
+	accountSeed, err := account.Seed()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		jwt     string
+		seed    string
+		wantErr string
+	}{
+		"mismatched seed": {
+			jwt:     userJWT,
+			seed:    otherSeed,
+			wantErr: "nkey seed is for",
+		},
+		"account seed": {
+			jwt:     userJWT,
+			seed:    string(accountSeed),
+			wantErr: "nkey seed is not a user seed",
+		},
+		"invalid seed": {
+			jwt:     userJWT,
+			seed:    "SUNOTASEED",
+			wantErr: "invalid nkey seed",
+		},
+		"invalid JWT": {
+			jwt:     "not-a-jwt",
+			seed:    otherSeed,
+			wantErr: "invalid user JWT",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := formatCreds(tt.jwt, tt.seed)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("got error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseCreds(t *testing.T) {
+	userJWT, seed, publicKey := newTestUser(t)
+	_, otherSeed, _ := newTestUser(t)
+
+	jwtBlock := credsBlock(credsJWTBlock, userJWT)
+	seedBlock := credsBlock(credsSeedBlock, seed)
+
+	tests := map[string]struct {
+		creds   string
+		wantErr string
+	}{
+		"decorated": {
+			creds: jwtBlock + "\n************************* IMPORTANT *************************\n" +
+				"NKEY Seed printed below can be used to sign and prove identity.\n\n" + seedBlock,
+		},
+		"indented with trailing spaces": {
+			creds: "  " + strings.ReplaceAll(jwtBlock, "\n", "  \n") + "\n\t" + seedBlock,
+		},
+		"misordered blocks": {
+			creds:   seedBlock + jwtBlock,
+			wantErr: `block 1: expected "NATS USER JWT"`,
+		},
+		"missing seed block": {
+			creds:   jwtBlock,
+			wantErr: "found 1 blocks",
+		},
+		"missing JWT block": {
+			creds:   seedBlock,
+			wantErr: "found 1 blocks",
+		},
+		"undecorated": {
+			creds:   userJWT + "\n" + seed + "\n",
+			wantErr: "found 0 blocks",
+		},
+		"extra block": {
+			creds:   jwtBlock + seedBlock + seedBlock,
+			wantErr: "found 3 blocks",
+		},
+		"mismatched BEGIN and END": {
+			creds:   "-----BEGIN NATS USER JWT-----\n" + userJWT + "\n------END USER NKEY SEED------\n" + seedBlock,
+			wantErr: `block 1: expected "NATS USER JWT", got BEGIN "NATS USER JWT" / END "USER NKEY SEED"`,
+		},
+		"mismatched seed": {
+			creds:   jwtBlock + credsBlock(credsSeedBlock, otherSeed),
+			wantErr: "nkey seed is for",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotJWT, gotSeed, gotPublicKey, err := parseCreds(tt.creds)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("got error %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if gotJWT != userJWT || gotSeed != seed || gotPublicKey != publicKey {
+				t.Errorf("got (%q, %q, %q), want (%q, %q, %q)", gotJWT, gotSeed, gotPublicKey, userJWT, seed, publicKey)
+			}
+		})
+	}
+}
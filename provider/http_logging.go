@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// httpLogSubsystem is the tflog subsystem request and response dumps are
+	// written to.
+	httpLogSubsystem = "synadia.http"
+
+	// httpLogLevelEnv enables HTTP logging and sets its level, e.g.
+	// TF_LOG_PROVIDER_SYNADIA_HTTP=DEBUG.
+	httpLogLevelEnv = "TF_LOG_PROVIDER_SYNADIA_HTTP"
+
+	// httpLogBodyLimit caps the number of body bytes included in a dump.
+	httpLogBodyLimit = 16 << 10
+)
+
+// httpLoggingEnabled reports whether the user asked for HTTP dumps. Logging is
+// opt-in so that TF_LOG=DEBUG alone does not put request bodies in the log.
+func httpLoggingEnabled() bool {
+	return os.Getenv(httpLogLevelEnv) != ""
+}
+
+// maskedHeaders are logged with their values replaced by tflog.
+var maskedHeaders = []string{
+	"authorization",
+	"proxy-authorization",
+	"cookie",
+	"set-cookie",
+	"x-api-key",
+}
+
+var (
+	// nkeySeedPattern matches encoded NKey seeds (SU..., SA..., SO...).
+	nkeySeedPattern = regexp.MustCompile(`\bS[UAONCXP][A-Z2-7]{56}\b`)
+
+	// credsSeedPattern matches the seed section of a .creds file.
+	credsSeedPattern = regexp.MustCompile(`(?s)-{3,}BEGIN USER NKEY SEED-{3,}.*?-{3,}END USER NKEY SEED-{3,}`)
+
+	// secretJSONPattern matches string values of JSON keys that hold
+	// credentials, escaped quotes included.
+	secretJSONPattern = regexp.MustCompile(`("(?:token|seed|creds|password|secret)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+	// jwtPattern matches a JWT, capturing its header and claims so the
+	// signature alone can be masked and the claims stay readable.
+	jwtPattern = regexp.MustCompile(`(eyJ[A-Za-z0-9_-]*\.eyJ[A-Za-z0-9_-]*)\.[A-Za-z0-9_-]+`)
+)
+
+// logTransport dumps every request and response to the synadia.http tflog
+// subsystem. It sits below the retry and limit transports so each attempt is
+// logged with the status it actually got.
+type logTransport struct {
+	base http.RoundTripper
+}
+
+func newLogTransport(base http.RoundTripper) *logTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &logTransport{base: base}
+}
+
+func (t *logTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := tflog.NewSubsystem(req.Context(), httpLogSubsystem, tflog.WithLevelFromEnv(httpLogLevelEnv))
+
+	keys := make([]string, 0, 2*len(maskedHeaders))
+	for _, h := range maskedHeaders {
+		keys = append(keys, "http.request.header."+h, "http.response.header."+h)
+	}
+	ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, httpLogSubsystem, keys...)
+	ctx = tflog.SubsystemMaskAllFieldValuesRegexes(ctx, httpLogSubsystem, nkeySeedPattern, credsSeedPattern)
+
+	fields := map[string]any{
+		"http.method": req.Method,
+		"http.url":    req.URL.String(),
+	}
+	addHeaderFields(fields, "http.request.header.", req.Header)
+
+	if req.Body != nil && req.Body != http.NoBody {
+		body, restored, err := peekRequestBody(req)
+		if err != nil {
+			return nil, err
+		}
+		req = restored
+		fields["http.request.body"] = redactBody(body)
+	}
+
+	tflog.SubsystemDebug(ctx, httpLogSubsystem, "sending Synadia API request", fields)
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+
+	fields = map[string]any{
+		"http.method":   req.Method,
+		"http.url":      req.URL.String(),
+		"http.duration": time.Since(start).String(),
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemDebug(ctx, httpLogSubsystem, "Synadia API request failed", fields)
+		return nil, err
+	}
+
+	fields["http.status"] = resp.StatusCode
+	addHeaderFields(fields, "http.response.header.", resp.Header)
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	fields["http.response.body"] = redactBody(body)
+
+	tflog.SubsystemDebug(ctx, httpLogSubsystem, "received Synadia API response", fields)
+
+	return resp, nil
+}
+
+func addHeaderFields(fields map[string]any, prefix string, header http.Header) {
+	for k, v := range header {
+		fields[prefix+strings.ToLower(k)] = strings.Join(v, ", ")
+	}
+}
+
+// peekRequestBody returns the request body and a request that can still be
+// sent. RoundTrippers must not consume the caller's body, so a copy is taken
+// through GetBody when possible.
+func peekRequestBody(req *http.Request) ([]byte, *http.Request, error) {
+	if req.GetBody != nil {
+		rc, err := req.GetBody()
+		if err != nil {
+			return nil, nil, err
+		}
+		defer rc.Close()
+
+		body, err := io.ReadAll(rc)
+		return body, req, err
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req = req.Clone(req.Context())
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, req, nil
+}
+
+// redactBody masks the credentials the control plane exchanges in JSON
+// (secret-valued keys, seeds, creds files and JWT signatures) and then
+// truncates body. Masking comes first so a secret cut in half by the
+// truncation is not left unmatched and logged in clear.
+func redactBody(body []byte) string {
+	s := string(body)
+	s = credsSeedPattern.ReplaceAllString(s, "***")
+	s = nkeySeedPattern.ReplaceAllString(s, "***")
+	s = secretJSONPattern.ReplaceAllString(s, `$1"***"`)
+	s = jwtPattern.ReplaceAllString(s, `$1.***`)
+
+	if len(s) > httpLogBodyLimit {
+		s = s[:httpLogBodyLimit] + "...(truncated)"
+	}
+	return s
+}
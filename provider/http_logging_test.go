@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRedactBody(t *testing.T) {
+	userJWT, seed, _ := newTestUser(t)
+	creds, err := formatCreds(userJWT, seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	header, claims, _ := strings.Cut(userJWT, ".")
+	claims, signature, _ := strings.Cut(claims, ".")
+
+	tests := map[string]struct {
+		body     string
+		want     []string
+		withheld []string
+	}{
+		"token": {
+			body:     `{"name":"ci","token":"uat_s3cr3t"}`,
+			want:     []string{`"name":"ci"`, `"token":"***"`},
+			withheld: []string{"uat_s3cr3t"},
+		},
+		"token with escaped quote": {
+			body:     `{"password" : "abc\"def"}`,
+			want:     []string{`"password" : "***"`},
+			withheld: []string{"abc", "def"},
+		},
+		"seed outside a secret key": {
+			body:     `{"nkey":"` + seed + `"}`,
+			want:     []string{`"nkey":"***"`},
+			withheld: []string{seed},
+		},
+		"creds": {
+			body:     `{"creds":` + strings.ReplaceAll(`"`+creds+`"`, "\n", `\n`) + `}`,
+			want:     []string{`"creds":"***"`},
+			withheld: []string{seed, signature},
+		},
+		"creds file": {
+			body:     creds,
+			want:     []string{header + "." + claims + ".***", "***"},
+			withheld: []string{seed, signature},
+		},
+		"JWT signature": {
+			body:     `{"jwt":"` + userJWT + `"}`,
+			want:     []string{`"jwt":"` + header + "." + claims + `.***"`},
+			withheld: []string{signature},
+		},
+		"secret split by truncation": {
+			body: `{"pad":"` + strings.Repeat("x", httpLogBodyLimit-30) + `","token":"uat_0123456789abcdef",` +
+				`"more":"` + strings.Repeat("y", 100) + `"}`,
+			want: []string{"...(truncated)"},
+			// The first characters of the token fit below the limit.
+			withheld: []string{"uat_0"},
+		},
+		"seed split by truncation": {
+			body:     strings.Repeat("x", httpLogBodyLimit-10) + " " + seed + " " + strings.Repeat("y", 100),
+			want:     []string{"...(truncated)"},
+			withheld: []string{seed[:9]},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := redactBody([]byte(tt.body))
+			// Long bodies are only shown by their end in failures.
+			shown := got[max(0, len(got)-300):]
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("redacted body does not contain %q:\n%s", want, shown)
+				}
+			}
+			for _, secret := range tt.withheld {
+				if strings.Contains(got, secret) {
+					t.Errorf("redacted body contains %q:\n%s", secret, shown)
+				}
+			}
+			if len(got) > httpLogBodyLimit+len("...(truncated)") {
+				t.Errorf("redacted body is %d bytes long", len(got))
+			}
+		})
+	}
+}
+
+func TestPeekRequestBody(t *testing.T) {
+	const body = `{"name":"orders"}`
+
+	tests := map[string]func() *http.Request{
+		"with GetBody": func() *http.Request {
+			req, _ := http.NewRequest(http.MethodPost, "https://synadia.invalid", strings.NewReader(body))
+			return req
+		},
+		"without GetBody": func() *http.Request {
+			req, _ := http.NewRequest(http.MethodPost, "https://synadia.invalid", nil)
+			req.Body = io.NopCloser(strings.NewReader(body))
+			return req
+		},
+	}
+
+	for name, newRequest := range tests {
+		t.Run(name, func(t *testing.T) {
+			peeked, req, err := peekRequestBody(newRequest())
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if string(peeked) != body {
+				t.Errorf("peeked %q, want %q", peeked, body)
+			}
+
+			sent, err := io.ReadAll(req.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(sent) != body {
+				t.Errorf("request body is %q after peeking, want %q", sent, body)
+			}
+		})
+	}
+}
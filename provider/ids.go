@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Objects nested under an account (streams, consumers, buckets) have no
+// control plane ID of their own, so their Terraform ID joins the parent
+// identifiers and the object name with slashes, e.g. "<account_id>/<stream>".
+// The same format is accepted by terraform import.
+
+func compositeID(parts ...string) string {
+	return strings.Join(parts, "/")
+}
+
+// parseCompositeID splits id into exactly n non-empty parts. format names the
+// expected layout and is used in the returned error.
+func parseCompositeID(id string, n int, format string) ([]string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != n {
+		return nil, fmt.Errorf("expected identifier with format %s, got: %q", format, id)
+	}
+	for _, p := range parts {
+		if p == "" {
+			return nil, fmt.Errorf("expected identifier with format %s, got: %q", format, id)
+		}
+	}
+	return parts, nil
+}
+
+// NanosPerSecond converts between the seconds exposed in schemas and the
+// nanosecond durations used by the JetStream API.
+const NanosPerSecond = int64(1_000_000_000)
@@ -0,0 +1,244 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// JetStream asset types reported by the jetstream_assets data source.
+const (
+	assetTypeStream = "stream"
+	assetTypeKV     = "kv"
+	assetTypeObject = "object"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &JetStreamAssetsDataSource{}
+
+func NewJetStreamAssetsDataSource() datasource.DataSource {
+	return &JetStreamAssetsDataSource{}
+}
+
+// JetStreamAssetsDataSource defines the data source implementation.
+type JetStreamAssetsDataSource struct {
+	client *Client
+}
+
+// JetStreamAssetsDataSourceModel describes the data source data model.
+type JetStreamAssetsDataSourceModel struct {
+	AccountId types.String          `tfsdk:"account_id"`
+	Type      types.String          `tfsdk:"type"`
+	NameRegex types.String          `tfsdk:"name_regex"`
+	Subject   types.String          `tfsdk:"subject"`
+	Storage   types.String          `tfsdk:"storage"`
+	Tags      types.Set             `tfsdk:"tags"`
+	Assets    []JetStreamAssetModel `tfsdk:"assets"`
+}
+
+// JetStreamAssetModel describes a single stream, KV or object bucket entry.
+type JetStreamAssetModel struct {
+	Id          types.String      `tfsdk:"id"`
+	Type        types.String      `tfsdk:"type"`
+	Name        types.String      `tfsdk:"name"`
+	Description types.String      `tfsdk:"description"`
+	Subjects    []string          `tfsdk:"subjects"`
+	Storage     types.String      `tfsdk:"storage"`
+	Replicas    types.Int64       `tfsdk:"replicas"`
+	MaxBytes    types.Int64       `tfsdk:"max_bytes"`
+	Tags        []string          `tfsdk:"tags"`
+	State       *StreamStateModel `tfsdk:"state"`
+}
+
+func newJetStreamAssetModel(accountID, assetType, name, description string, subjects []string, storage string, replicas, maxBytes int64, tags []string, state *StreamState) JetStreamAssetModel {
+	return JetStreamAssetModel{
+		Id:          types.StringValue(compositeID(accountID, name)),
+		Type:        types.StringValue(assetType),
+		Name:        types.StringValue(name),
+		Description: types.StringValue(description),
+		Subjects:    append([]string{}, subjects...),
+		Storage:     types.StringValue(storage),
+		Replicas:    types.Int64Value(replicas),
+		MaxBytes:    types.Int64Value(maxBytes),
+		Tags:        append([]string{}, tags...),
+		State:       streamStateFromAPI(state),
+	}
+}
+
+func (d *JetStreamAssetsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jetstream_assets"
+}
+
+func (d *JetStreamAssetsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := map[string]schema.Attribute{
+		"account_id": schema.StringAttribute{
+			MarkdownDescription: "Account to list JetStream assets in",
+			Required:            true,
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: "Only return assets of this type: `stream`, `kv` or `object`",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(assetTypeStream, assetTypeKV, assetTypeObject),
+			},
+		},
+		"assets": schema.ListNestedAttribute{
+			MarkdownDescription: "Matching assets: streams first, then KV buckets, then object buckets",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						MarkdownDescription: "Asset identifier in the form `<account_id>/<name>`, as used by the " +
+							"resource of its type",
+						Computed: true,
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Asset type: `stream`, `kv` or `object`",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Stream or bucket name",
+						Computed:            true,
+					},
+					"description": schema.StringAttribute{
+						MarkdownDescription: "Asset description",
+						Computed:            true,
+					},
+					"subjects": schema.ListAttribute{
+						MarkdownDescription: "Subjects the asset's stream captures. For buckets these are the " +
+							"internal `$KV.<bucket>.>` and `$O.<bucket>.…` subjects.",
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+					"storage": schema.StringAttribute{
+						MarkdownDescription: "Storage backend",
+						Computed:            true,
+					},
+					"replicas": schema.Int64Attribute{
+						MarkdownDescription: "Number of replicas",
+						Computed:            true,
+					},
+					"max_bytes": schema.Int64Attribute{
+						MarkdownDescription: "Maximum size of the asset in bytes, `-1` for unlimited",
+						Computed:            true,
+					},
+					"tags": schema.ListAttribute{
+						MarkdownDescription: "Tags of the asset",
+						ElementType:         types.StringType,
+						Computed:            true,
+					},
+					"state": streamStateAttribute("asset"),
+				},
+			},
+		},
+	}
+	maps.Copy(attrs, assetFilterAttributes("assets", true))
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists all JetStream streams, KV buckets and object buckets of an account together with " +
+			"their runtime state.",
+
+		Attributes: attrs,
+	}
+}
+
+func (d *JetStreamAssetsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *JetStreamAssetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data JetStreamAssetsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := newAssetFilter(ctx, data.NameRegex, data.Subject, data.Storage, data.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountID := data.AccountId.ValueString()
+	assetType := data.Type.ValueString()
+	data.Assets = []JetStreamAssetModel{}
+
+	if assetType == "" || assetType == assetTypeStream {
+		streams, err := d.client.ListStreams(ctx, accountID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list streams, got error: %s", err))
+			return
+		}
+		for _, s := range streams {
+			cfg := s.Config
+			if IsBucketStream(cfg.Name) || !filter.matches(cfg.Name, cfg.Subjects, cfg.Storage, s.Tags) {
+				continue
+			}
+			data.Assets = append(data.Assets, newJetStreamAssetModel(accountID, assetTypeStream, cfg.Name,
+				cfg.Description, cfg.Subjects, cfg.Storage, cfg.Replicas, cfg.MaxBytes, s.Tags, s.State))
+		}
+	}
+
+	if assetType == "" || assetType == assetTypeKV {
+		buckets, err := d.client.ListKVBuckets(ctx, accountID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list KV buckets, got error: %s", err))
+			return
+		}
+		for _, b := range buckets {
+			cfg := b.Config
+			subjects := kvBucketSubjects(cfg.Bucket)
+			if !filter.matches(cfg.Bucket, subjects, cfg.Storage, b.Tags) {
+				continue
+			}
+			data.Assets = append(data.Assets, newJetStreamAssetModel(accountID, assetTypeKV, cfg.Bucket,
+				cfg.Description, subjects, cfg.Storage, cfg.Replicas, cfg.MaxBytes, b.Tags, b.State))
+		}
+	}
+
+	if assetType == "" || assetType == assetTypeObject {
+		buckets, err := d.client.ListObjectBuckets(ctx, accountID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list object buckets, got error: %s", err))
+			return
+		}
+		for _, b := range buckets {
+			cfg := b.Config
+			subjects := objectBucketSubjects(cfg.Bucket)
+			if !filter.matches(cfg.Bucket, subjects, cfg.Storage, b.Tags) {
+				continue
+			}
+			data.Assets = append(data.Assets, newJetStreamAssetModel(accountID, assetTypeObject, cfg.Bucket,
+				cfg.Description, subjects, cfg.Storage, cfg.Replicas, cfg.MaxBytes, b.Tags, b.State))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
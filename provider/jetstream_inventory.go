@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The JetStream data sources share the filters and the computed state and
+// placement attributes defined here. Filters are applied client side to
+// whatever the control plane returns for the account.
+
+// assetFilter matches JetStream assets against the optional name_regex,
+// subject, storage and tags filters. Unset filters match everything.
+type assetFilter struct {
+	nameRegex *regexp.Regexp
+	subject   string
+	storage   string
+	tags      []string
+}
+
+// newAssetFilter builds a filter from the data source configuration. subject
+// is null for data sources that do not offer a subject filter.
+func newAssetFilter(ctx context.Context, nameRegex, subject, storage types.String, tags types.Set) (*assetFilter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	f := &assetFilter{
+		subject: subject.ValueString(),
+		storage: storage.ValueString(),
+	}
+
+	if !nameRegex.IsNull() {
+		re, err := regexp.Compile(nameRegex.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("name_regex"), "Invalid Name Regex",
+				fmt.Sprintf("Unable to compile name_regex, got error: %s", err))
+		}
+		f.nameRegex = re
+	}
+
+	if f.subject != "" {
+		if err := validateSubject(f.subject); err != nil {
+			diags.AddAttributeError(path.Root("subject"), "Invalid Subject",
+				fmt.Sprintf("Subject %q is not a valid NATS subject: %s.", f.subject, err))
+		}
+	}
+
+	diags.Append(tags.ElementsAs(ctx, &f.tags, false)...)
+	return f, diags
+}
+
+// matches reports whether an asset passes every filter. An asset matches the
+// subject filter when one of its subjects overlaps it, and the tags filter
+// when it carries all of the given tags.
+func (f *assetFilter) matches(name string, subjects []string, storage string, tags []string) bool {
+	if f.nameRegex != nil && !f.nameRegex.MatchString(name) {
+		return false
+	}
+	if f.storage != "" && storage != f.storage {
+		return false
+	}
+	if f.subject != "" && !slices.ContainsFunc(subjects, func(s string) bool {
+		return subjectsOverlap(f.subject, s)
+	}) {
+		return false
+	}
+	for _, tag := range f.tags {
+		if !slices.Contains(tags, tag) {
+			return false
+		}
+	}
+	return true
+}
+
+// assetFilterAttributes returns the filter attributes of a data source listing
+// kind. The subject filter is only offered when withSubject is set.
+func assetFilterAttributes(kind string, withSubject bool) map[string]schema.Attribute {
+	attrs := map[string]schema.Attribute{
+		"name_regex": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Only return %s whose name matches this regular expression", kind),
+			Optional:            true,
+		},
+		"storage": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Only return %s with this storage type: `file` or `memory`", kind),
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("file", "memory"),
+			},
+		},
+		"tags": schema.SetAttribute{
+			MarkdownDescription: fmt.Sprintf("Only return %s carrying all of these tags", kind),
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+	}
+	if withSubject {
+		attrs["subject"] = schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Only return %s with a subject overlapping this subject, which may "+
+				"contain wildcards", kind),
+			Optional: true,
+		}
+	}
+	return attrs
+}
+
+// StreamStateModel describes the runtime state of a stream or bucket.
+type StreamStateModel struct {
+	Messages      types.Int64 `tfsdk:"messages"`
+	Bytes         types.Int64 `tfsdk:"bytes"`
+	FirstSeq      types.Int64 `tfsdk:"first_seq"`
+	LastSeq       types.Int64 `tfsdk:"last_seq"`
+	ConsumerCount types.Int64 `tfsdk:"consumer_count"`
+}
+
+// streamStateFromAPI converts the runtime state of an asset. Assets the control
+// plane reports no state for yield nil.
+func streamStateFromAPI(s *StreamState) *StreamStateModel {
+	if s == nil {
+		return nil
+	}
+	return &StreamStateModel{
+		Messages:      types.Int64Value(s.Messages),
+		Bytes:         types.Int64Value(s.Bytes),
+		FirstSeq:      types.Int64Value(s.FirstSeq),
+		LastSeq:       types.Int64Value(s.LastSeq),
+		ConsumerCount: types.Int64Value(s.ConsumerCount),
+	}
+}
+
+func streamStateAttribute(kind string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: fmt.Sprintf("Runtime state of the %s at the time it was read", kind),
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"messages": schema.Int64Attribute{
+				MarkdownDescription: "Number of messages stored",
+				Computed:            true,
+			},
+			"bytes": schema.Int64Attribute{
+				MarkdownDescription: "Number of bytes stored",
+				Computed:            true,
+			},
+			"first_seq": schema.Int64Attribute{
+				MarkdownDescription: "Sequence number of the first stored message",
+				Computed:            true,
+			},
+			"last_seq": schema.Int64Attribute{
+				MarkdownDescription: "Sequence number of the last stored message",
+				Computed:            true,
+			},
+			"consumer_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of consumers",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Subjects of the streams backing KV and object buckets, used by the subject
+// filter of jetstream_assets.
+func kvBucketSubjects(bucket string) []string {
+	return []string{"$KV." + bucket + ".>"}
+}
+
+func objectBucketSubjects(bucket string) []string {
+	return []string{"$O." + bucket + ".C.>", "$O." + bucket + ".M.>"}
+}
+
+// placementDataSourceAttribute returns the computed counterpart of
+// placementAttribute for data sources.
+func placementDataSourceAttribute(asset string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: fmt.Sprintf("Where the %s's replicas are placed", asset),
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				MarkdownDescription: "Cluster replicas are placed in",
+				Computed:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Server tags every replica's server must carry",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &JetStreamPlacementOptionsDataSource{}
+
+func NewJetStreamPlacementOptionsDataSource() datasource.DataSource {
+	return &JetStreamPlacementOptionsDataSource{}
+}
+
+// JetStreamPlacementOptionsDataSource defines the data source implementation.
+type JetStreamPlacementOptionsDataSource struct {
+	client *Client
+}
+
+// JetStreamPlacementOptionsDataSourceModel describes the data source data model.
+type JetStreamPlacementOptionsDataSourceModel struct {
+	SystemId types.String `tfsdk:"system_id"`
+	Clusters []string     `tfsdk:"clusters"`
+	Tags     []string     `tfsdk:"tags"`
+}
+
+func (d *JetStreamPlacementOptionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jetstream_placement_options"
+}
+
+func (d *JetStreamPlacementOptionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the clusters and server tags JetStream assets in a system can be placed on, for use " +
+			"in the `placement` attribute of `synadia_stream`, `synadia_kv_bucket` and `synadia_object_bucket`.",
+
+		Attributes: map[string]schema.Attribute{
+			"system_id": schema.StringAttribute{
+				MarkdownDescription: "System to list placement options for",
+				Required:            true,
+			},
+			"clusters": schema.ListAttribute{
+				MarkdownDescription: "Names of the clusters available for placement",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"tags": schema.ListAttribute{
+				MarkdownDescription: "Server tags available for placement",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *JetStreamPlacementOptionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *JetStreamPlacementOptionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data JetStreamPlacementOptionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options, err := d.client.GetPlacementOptions(ctx, data.SystemId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read placement options, got error: %s", err))
+		return
+	}
+
+	data.Clusters = append([]string{}, options.Clusters...)
+	data.Tags = append([]string{}, options.Tags...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nats-io/jwt/v2"
+	"github.com/nats-io/nkeys"
+)
+
+var (
+	_ function.Function = JWTDecodeFunction{}
+)
+
+func NewJWTDecodeFunction() function.Function {
+	return JWTDecodeFunction{}
+}
+
+type JWTDecodeFunction struct{}
+
+// jwtClaimsModel is the object returned by jwt_decode.
+type jwtClaimsModel struct {
+	Type          types.String         `tfsdk:"type"`
+	Name          types.String         `tfsdk:"name"`
+	Subject       types.String         `tfsdk:"subject"`
+	Issuer        types.String         `tfsdk:"issuer"`
+	IssuerAccount types.String         `tfsdk:"issuer_account"`
+	Audience      types.String         `tfsdk:"audience"`
+	ID            types.String         `tfsdk:"id"`
+	IssuedAt      types.Int64          `tfsdk:"issued_at"`
+	Expires       types.Int64          `tfsdk:"expires"`
+	NotBefore     types.Int64          `tfsdk:"not_before"`
+	Tags          []string             `tfsdk:"tags"`
+	Permissions   *jwtPermissionsModel `tfsdk:"permissions"`
+	Limits        *jwtLimitsModel      `tfsdk:"limits"`
+	JSON          string               `tfsdk:"json"`
+}
+
+type jwtPermissionsModel struct {
+	Pub jwtPermissionModel `tfsdk:"pub"`
+	Sub jwtPermissionModel `tfsdk:"sub"`
+}
+
+type jwtPermissionModel struct {
+	Allow []string `tfsdk:"allow"`
+	Deny  []string `tfsdk:"deny"`
+}
+
+type jwtLimitsModel struct {
+	Subs    int64    `tfsdk:"subs"`
+	Data    int64    `tfsdk:"data"`
+	Payload int64    `tfsdk:"payload"`
+	Src     []string `tfsdk:"src"`
+}
+
+var jwtPermissionAttrTypes = map[string]attr.Type{
+	"allow": types.ListType{ElemType: types.StringType},
+	"deny":  types.ListType{ElemType: types.StringType},
+}
+
+var jwtClaimsAttrTypes = map[string]attr.Type{
+	"type":           types.StringType,
+	"name":           types.StringType,
+	"subject":        types.StringType,
+	"issuer":         types.StringType,
+	"issuer_account": types.StringType,
+	"audience":       types.StringType,
+	"id":             types.StringType,
+	"issued_at":      types.Int64Type,
+	"expires":        types.Int64Type,
+	"not_before":     types.Int64Type,
+	"tags":           types.ListType{ElemType: types.StringType},
+	"permissions": types.ObjectType{AttrTypes: map[string]attr.Type{
+		"pub": types.ObjectType{AttrTypes: jwtPermissionAttrTypes},
+		"sub": types.ObjectType{AttrTypes: jwtPermissionAttrTypes},
+	}},
+	"limits": types.ObjectType{AttrTypes: map[string]attr.Type{
+		"subs":    types.Int64Type,
+		"data":    types.Int64Type,
+		"payload": types.Int64Type,
+		"src":     types.ListType{ElemType: types.StringType},
+	}},
+	"json": types.StringType,
+}
+
+func (r JWTDecodeFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "jwt_decode"
+}
+
+func (r JWTDecodeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Decode a NATS JWT",
+		MarkdownDescription: "Decodes an operator, account, user or activation JWT and verifies that it is signed by " +
+			"its `issuer`. If an issuer public key is given, the JWT must also be issued by that key; for a JWT " +
+			"signed with an account signing key, pass the signing key, not the account. Times are Unix seconds and " +
+			"are `null` when unset. `permissions` is only set for user JWTs and `limits` for user and account JWTs; `json` holds " +
+			"the complete claims.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "jwt",
+				MarkdownDescription: "Encoded JWT",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:                "issuer",
+			MarkdownDescription: "Optional public key (operator or account nkey) the JWT must be issued by",
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: jwtClaimsAttrTypes,
+		},
+	}
+}
+
+func (r JWTDecodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var token string
+	var issuers []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &token, &issuers))
+
+	if resp.Error != nil {
+		return
+	}
+
+	if len(issuers) > 1 {
+		resp.Error = function.NewArgumentFuncError(1, "At most one issuer public key may be given")
+		return
+	}
+
+	claims, err := jwt.Decode(strings.TrimSpace(token))
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid JWT: %s", err))
+		return
+	}
+
+	data := newJWTClaimsModel(claims)
+
+	if len(issuers) == 1 {
+		issuer := issuers[0]
+		if !nkeys.IsValidPublicKey(issuer) {
+			resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid issuer public key %q", issuer))
+			return
+		}
+		if issuer != data.Issuer.ValueString() {
+			resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("JWT is issued by %s, not %s", data.Issuer.ValueString(), issuer))
+			return
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, data))
+}
+
+func newJWTClaimsModel(claims jwt.Claims) *jwtClaimsModel {
+	c := claims.Claims()
+
+	m := &jwtClaimsModel{
+		Type:          types.StringValue(string(claims.ClaimType())),
+		Name:          optionalString(c.Name),
+		Subject:       types.StringValue(c.Subject),
+		Issuer:        types.StringValue(c.Issuer),
+		IssuerAccount: types.StringNull(),
+		Audience:      optionalString(c.Audience),
+		ID:            optionalString(c.ID),
+		IssuedAt:      optionalUnixTime(c.IssuedAt),
+		Expires:       optionalUnixTime(c.Expires),
+		NotBefore:     optionalUnixTime(c.NotBefore),
+		JSON:          claims.String(),
+	}
+
+	switch cl := claims.(type) {
+	case *jwt.UserClaims:
+		m.IssuerAccount = optionalString(cl.IssuerAccount)
+		m.Tags = cl.Tags
+		m.Permissions = &jwtPermissionsModel{
+			Pub: jwtPermissionModel{Allow: cl.Pub.Allow, Deny: cl.Pub.Deny},
+			Sub: jwtPermissionModel{Allow: cl.Sub.Allow, Deny: cl.Sub.Deny},
+		}
+		m.Limits = &jwtLimitsModel{
+			Subs:    cl.Subs,
+			Data:    cl.Data,
+			Payload: cl.NatsLimits.Payload,
+			Src:     cl.Src,
+		}
+	case *jwt.AccountClaims:
+		m.Tags = cl.Tags
+		m.Limits = &jwtLimitsModel{
+			Subs:    cl.Limits.Subs,
+			Data:    cl.Limits.Data,
+			Payload: cl.Limits.Payload,
+		}
+	case *jwt.OperatorClaims:
+		m.Tags = cl.Tags
+	case *jwt.ActivationClaims:
+		m.IssuerAccount = optionalString(cl.IssuerAccount)
+		m.Tags = cl.Tags
+	}
+
+	return m
+}
+
+// optionalString returns a null String for the empty string.
+func optionalString(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+// optionalUnixTime returns a null Int64 for the zero JWT timestamp.
+func optionalUnixTime(t int64) types.Int64 {
+	if t == 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(t)
+}
@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nats-io/jwt/v2"
+	"github.com/nats-io/nkeys"
+)
+
+func runJWTDecode(t *testing.T, token string, issuers ...string) (types.Object, *function.FuncError) {
+	t.Helper()
+
+	issuerValues := make([]attr.Value, len(issuers))
+	issuerTypes := make([]attr.Type, len(issuers))
+	for i, issuer := range issuers {
+		issuerValues[i] = types.StringValue(issuer)
+		issuerTypes[i] = types.StringType
+	}
+
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue(token),
+			types.TupleValueMust(issuerTypes, issuerValues),
+		}),
+	}
+	resp := &function.RunResponse{
+		Result: function.NewResultData(types.ObjectUnknown(jwtClaimsAttrTypes)),
+	}
+
+	NewJWTDecodeFunction().Run(context.Background(), req, resp)
+
+	result, _ := resp.Result.Value().(types.Object)
+	return result, resp.Error
+}
+
+func TestJWTDecodeFunction(t *testing.T) {
+	account, err := nkeys.CreateAccount()
+	if err != nil {
+		t.Fatal(err)
+	}
+	accountKey, err := account.PublicKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	other, err := nkeys.CreateAccount()
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKey, err := other.PublicKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	user, err := nkeys.CreateUser()
+	if err != nil {
+		t.Fatal(err)
+	}
+	userKey, err := user.PublicKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	claims := jwt.NewUserClaims(userKey)
+	claims.Name = "alice"
+	claims.Pub.Allow.Add("orders.>")
+	token, err := claims.Encode(account)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Flip a character in the middle of the signature; the last one may only
+	// carry padding bits.
+	i := strings.LastIndex(token, ".") + 20
+	flipped := byte('A')
+	if token[i] == 'A' {
+		flipped = 'B'
+	}
+	tampered := token[:i] + string(flipped) + token[i+1:]
+
+	tests := map[string]struct {
+		token        string
+		issuers      []string
+		wantErr      string
+		wantArgument int64
+	}{
+		"valid": {
+			token: token,
+		},
+		"valid with issuer": {
+			token:   " " + token + "\n",
+			issuers: []string{accountKey},
+		},
+		"tampered signature": {
+			token:   tampered,
+			wantErr: "Invalid JWT",
+		},
+		"wrong issuer": {
+			token:        token,
+			issuers:      []string{otherKey},
+			wantErr:      "JWT is issued by " + accountKey + ", not " + otherKey,
+			wantArgument: 1,
+		},
+		"invalid issuer key": {
+			token:        token,
+			issuers:      []string{"ANOTAKEY"},
+			wantErr:      "Invalid issuer public key",
+			wantArgument: 1,
+		},
+		"two issuers": {
+			token:        token,
+			issuers:      []string{accountKey, otherKey},
+			wantErr:      "At most one issuer",
+			wantArgument: 1,
+		},
+		"malformed": {
+			token:   "not-a-jwt",
+			wantErr: "Invalid JWT",
+		},
+		"empty": {
+			wantErr: "Invalid JWT",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, funcErr := runJWTDecode(t, tt.token, tt.issuers...)
+
+			if tt.wantErr != "" {
+				if funcErr == nil || !strings.Contains(funcErr.Text, tt.wantErr) {
+					t.Fatalf("got error %v, want %q", funcErr, tt.wantErr)
+				}
+				if funcErr.FunctionArgument == nil || *funcErr.FunctionArgument != tt.wantArgument {
+					t.Errorf("got error on argument %v, want %d", funcErr.FunctionArgument, tt.wantArgument)
+				}
+				return
+			}
+			if funcErr != nil {
+				t.Fatalf("unexpected error: %s", funcErr)
+			}
+
+			attrs := result.Attributes()
+			for attribute, want := range map[string]attr.Value{
+				"type":    types.StringValue(string(jwt.UserClaim)),
+				"name":    types.StringValue("alice"),
+				"subject": types.StringValue(userKey),
+				"issuer":  types.StringValue(accountKey),
+				"expires": types.Int64Null(),
+			} {
+				if got := attrs[attribute]; !got.Equal(want) {
+					t.Errorf("got %s %s, want %s", attribute, got, want)
+				}
+			}
+			if permissions := attrs["permissions"].String(); !strings.Contains(permissions, `"orders.>"`) {
+				t.Errorf("got permissions %s, want orders.> allowed for publishing", permissions)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/sync/semaphore"
+)
+
+// keyedLocks hands out one mutex per key. The control plane re-signs the
+// account JWT whenever a NATS user is added, changed or removed, and the
+// system's account list whenever an account is created or deleted. Two such
+// calls racing on the same account let the later write clobber the earlier
+// one, so the Client serializes them per account or system while unrelated
+// keys still proceed in parallel.
+//
+// Callers that cannot tell which account they re-sign take every key at once
+// with lockAll instead.
+//
+// Unlike sync.Mutex, waiting for a key can be abandoned by cancelling ctx.
+type keyedLocks struct {
+	// all is held shared by every key lock and exclusively by lockAll.
+	all *semaphore.Weighted
+
+	mu    sync.Mutex
+	locks map[string]*keyedLock
+}
+
+type keyedLock struct {
+	held chan struct{}
+	refs int
+}
+
+func newKeyedLocks() *keyedLocks {
+	return &keyedLocks{
+		all:   semaphore.NewWeighted(math.MaxInt64),
+		locks: map[string]*keyedLock{},
+	}
+}
+
+// lock blocks until key is free or ctx is done. The returned function releases
+// the lock and must be called exactly once.
+func (k *keyedLocks) lock(ctx context.Context, key string) (func(), error) {
+	start := time.Now()
+	if err := k.all.Acquire(ctx, 1); err != nil {
+		return nil, err
+	}
+
+	k.mu.Lock()
+	l, ok := k.locks[key]
+	if !ok {
+		l = &keyedLock{held: make(chan struct{}, 1)}
+		k.locks[key] = l
+	}
+	l.refs++
+	k.mu.Unlock()
+
+	select {
+	case l.held <- struct{}{}:
+	case <-ctx.Done():
+		k.release(key, l)
+		k.all.Release(1)
+		return nil, ctx.Err()
+	}
+
+	logLockWait(ctx, key, start)
+
+	return func() {
+		<-l.held
+		k.release(key, l)
+		k.all.Release(1)
+	}, nil
+}
+
+// lockAll blocks until no key is held or ctx is done, and keeps every key
+// locked until the returned function is called. It must be called exactly
+// once.
+func (k *keyedLocks) lockAll(ctx context.Context) (func(), error) {
+	start := time.Now()
+	if err := k.all.Acquire(ctx, math.MaxInt64); err != nil {
+		return nil, err
+	}
+
+	logLockWait(ctx, "*", start)
+
+	return func() {
+		k.all.Release(math.MaxInt64)
+	}, nil
+}
+
+func logLockWait(ctx context.Context, key string, start time.Time) {
+	if waited := time.Since(start); waited > time.Millisecond {
+		tflog.Debug(ctx, "waited for Synadia JWT lock", map[string]any{
+			"lock":   key,
+			"waited": waited.String(),
+		})
+	}
+}
+
+// release drops a reference to l and forgets it once nobody holds or waits
+// for it, so the map only grows with the keys currently in use.
+func (k *keyedLocks) release(key string, l *keyedLock) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	l.refs--
+	if l.refs == 0 {
+		delete(k.locks, key)
+	}
+}
+
+// sharedJWTLocks is used by every Client in the process and, through
+// LockAllJWTs, by the muxed SDKv2 provider, so aliased provider instances and
+// both halves of the muxed provider serialize with each other.
+var sharedJWTLocks = newKeyedLocks()
+
+// LockAllJWTs serializes a call that re-signs an account or user JWT it
+// cannot attribute to an account with every other such call in the process,
+// including the framework provider's per-account and per-system ones. The
+// SDKv2 resources use it as they are addressed by cluster or user rather than
+// account. The returned function releases the lock and must be called exactly
+// once.
+func LockAllJWTs(ctx context.Context) (func(), error) {
+	return sharedJWTLocks.lockAll(ctx)
+}
+
+// lockAccount serializes calls that re-sign the JWT of accountID.
+func (c *Client) lockAccount(ctx context.Context, accountID string) (func(), error) {
+	return c.jwtLocks.lock(ctx, "account/"+accountID)
+}
+
+// lockSystem serializes calls that change the account list of systemID.
+func (c *Client) lockSystem(ctx context.Context, systemID string) (func(), error) {
+	return c.jwtLocks.lock(ctx, "system/"+systemID)
+}
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// lockHeld reports whether lock is still waiting after a short while.
+func lockHeld(t *testing.T, lock func(context.Context) (func(), error)) bool {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	unlock, err := lock(ctx)
+	if err == nil {
+		unlock()
+		return false
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	return true
+}
+
+func TestJWTLocks_SharedAcrossClients(t *testing.T) {
+	ctx := context.Background()
+	a := NewClient("https://a.example.com", "a", nil)
+	b := NewClient("https://b.example.com", "b", nil)
+
+	unlock, err := a.lockAccount(ctx, "ACC")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !lockHeld(t, func(ctx context.Context) (func(), error) { return b.lockAccount(ctx, "ACC") }) {
+		t.Error("another client got the lock of a held account")
+	}
+	if lockHeld(t, func(ctx context.Context) (func(), error) { return b.lockAccount(ctx, "OTHER") }) {
+		t.Error("another account is blocked")
+	}
+	if !lockHeld(t, LockAllJWTs) {
+		t.Error("LockAllJWTs got the lock while an account is held")
+	}
+
+	unlock()
+
+	if n := len(sharedJWTLocks.locks); n != 0 {
+		t.Errorf("%d locks left in the table", n)
+	}
+}
+
+// TestLockAllJWTs_BlocksFramework checks that the lock taken by the SDKv2
+// resources blocks the framework provider's account and system locks until it
+// is released.
+func TestLockAllJWTs_BlocksFramework(t *testing.T) {
+	ctx := context.Background()
+	c := NewClient("https://a.example.com", "a", nil)
+
+	unlockAll, err := LockAllJWTs(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, lock := range map[string]func(context.Context) (func(), error){
+		"account": func(ctx context.Context) (func(), error) { return c.lockAccount(ctx, "ACC") },
+		"system":  func(ctx context.Context) (func(), error) { return c.lockSystem(ctx, "SYS") },
+		"all":     LockAllJWTs,
+	} {
+		if !lockHeld(t, lock) {
+			t.Errorf("%s lock is not blocked", name)
+		}
+	}
+
+	locked := make(chan func())
+	go func() {
+		unlock, err := c.lockAccount(ctx, "ACC")
+		if err != nil {
+			t.Error(err)
+		}
+		locked <- unlock
+	}()
+
+	select {
+	case <-locked:
+		t.Fatal("account locked before LockAllJWTs was released")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	unlockAll()
+
+	select {
+	case unlock := <-locked:
+		unlock()
+	case <-time.After(time.Second):
+		t.Fatal("account not locked after LockAllJWTs was released")
+	}
+
+	if n := len(sharedJWTLocks.locks); n != 0 {
+		t.Errorf("%d locks left in the table", n)
+	}
+}
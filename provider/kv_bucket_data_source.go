@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &KVBucketDataSource{}
+
+func NewKVBucketDataSource() datasource.DataSource {
+	return &KVBucketDataSource{}
+}
+
+// KVBucketDataSource defines the data source implementation.
+type KVBucketDataSource struct {
+	client *Client
+}
+
+// KVBucketDataSourceModel describes the data source data model.
+type KVBucketDataSourceModel struct {
+	AccountId    types.String      `tfsdk:"account_id"`
+	Bucket       types.String      `tfsdk:"bucket"`
+	Id           types.String      `tfsdk:"id"`
+	Description  types.String      `tfsdk:"description"`
+	History      types.Int64       `tfsdk:"history"`
+	TTLSeconds   types.Int64       `tfsdk:"ttl_seconds"`
+	MaxValueSize types.Int64       `tfsdk:"max_value_size"`
+	MaxBytes     types.Int64       `tfsdk:"max_bytes"`
+	Storage      types.String      `tfsdk:"storage"`
+	Replicas     types.Int64       `tfsdk:"replicas"`
+	Placement    types.Object      `tfsdk:"placement"`
+	Tags         []string          `tfsdk:"tags"`
+	State        *StreamStateModel `tfsdk:"state"`
+}
+
+func (d *KVBucketDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kv_bucket"
+}
+
+func (d *KVBucketDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the configuration and runtime state of a JetStream key value bucket, e.g. one " +
+			"managed by another configuration.",
+
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "Account the bucket belongs to",
+				Required:            true,
+			},
+			"bucket": schema.StringAttribute{
+				MarkdownDescription: "Bucket name",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Bucket identifier in the form `<account_id>/<bucket>`",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Bucket description",
+				Computed:            true,
+			},
+			"history": schema.Int64Attribute{
+				MarkdownDescription: "Number of historical values kept per key",
+				Computed:            true,
+			},
+			"ttl_seconds": schema.Int64Attribute{
+				MarkdownDescription: "Time to live of values in seconds, `0` for no expiry",
+				Computed:            true,
+			},
+			"max_value_size": schema.Int64Attribute{
+				MarkdownDescription: "Maximum size of a single value in bytes, `-1` for unlimited",
+				Computed:            true,
+			},
+			"max_bytes": schema.Int64Attribute{
+				MarkdownDescription: "Maximum size of the bucket in bytes, `-1` for unlimited",
+				Computed:            true,
+			},
+			"storage": schema.StringAttribute{
+				MarkdownDescription: "Storage backend",
+				Computed:            true,
+			},
+			"replicas": schema.Int64Attribute{
+				MarkdownDescription: "Number of replicas",
+				Computed:            true,
+			},
+			"placement": placementDataSourceAttribute("bucket"),
+			"tags": schema.ListAttribute{
+				MarkdownDescription: "Tags of the bucket",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"state": streamStateAttribute("bucket"),
+		},
+	}
+}
+
+func (d *KVBucketDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *KVBucketDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data KVBucketDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountID := data.AccountId.ValueString()
+	name := data.Bucket.ValueString()
+
+	bucket, err := d.client.GetKVBucket(ctx, accountID, name)
+	if IsNotFound(err) {
+		resp.Diagnostics.AddAttributeError(path.Root("bucket"), "KV Bucket Not Found",
+			fmt.Sprintf("Account %q has no KV bucket named %q.", accountID, name))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read KV bucket, got error: %s", err))
+		return
+	}
+
+	cfg := bucket.Config
+	data.Id = types.StringValue(compositeID(accountID, cfg.Bucket))
+	data.Description = types.StringValue(cfg.Description)
+	data.History = types.Int64Value(cfg.History)
+	data.TTLSeconds = types.Int64Value(cfg.TTL / NanosPerSecond)
+	data.MaxValueSize = types.Int64Value(cfg.MaxValueSize)
+	data.MaxBytes = types.Int64Value(cfg.MaxBytes)
+	data.Storage = types.StringValue(cfg.Storage)
+	data.Replicas = types.Int64Value(cfg.Replicas)
+	data.Tags = append([]string{}, bucket.Tags...)
+	data.State = streamStateFromAPI(bucket.State)
+
+	placement, diags := placementFromAPI(ctx, cfg.Placement)
+	resp.Diagnostics.Append(diags...)
+	data.Placement = placement
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,418 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &KVBucketResource{}
+var _ resource.ResourceWithImportState = &KVBucketResource{}
+var _ resource.ResourceWithIdentity = &KVBucketResource{}
+var _ resource.ResourceWithModifyPlan = &KVBucketResource{}
+
+func NewKVBucketResource() resource.Resource {
+	return &KVBucketResource{}
+}
+
+// KVBucketResource defines the resource implementation.
+type KVBucketResource struct {
+	client *Client
+}
+
+// KVBucketResourceModel describes the resource data model.
+type KVBucketResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	AccountId    types.String `tfsdk:"account_id"`
+	Bucket       types.String `tfsdk:"bucket"`
+	Description  types.String `tfsdk:"description"`
+	History      types.Int64  `tfsdk:"history"`
+	TTLSeconds   types.Int64  `tfsdk:"ttl_seconds"`
+	MaxValueSize types.Int64  `tfsdk:"max_value_size"`
+	MaxBytes     types.Int64  `tfsdk:"max_bytes"`
+	Storage      types.String `tfsdk:"storage"`
+	Replicas     types.Int64  `tfsdk:"replicas"`
+	Placement    types.Object `tfsdk:"placement"`
+}
+
+// kvBucketAPIFields maps kv bucket request fields to schema attributes.
+var kvBucketAPIFields = apiFieldPaths{
+	"bucket":         path.Root("bucket"),
+	"description":    path.Root("description"),
+	"history":        path.Root("history"),
+	"ttl":            path.Root("ttl_seconds"),
+	"max_value_size": path.Root("max_value_size"),
+	"max_bytes":      path.Root("max_bytes"),
+	"storage":        path.Root("storage"),
+	"replicas":       path.Root("replicas"),
+}.withPlacement()
+
+// KVBucketResourceIdentityModel describes the resource identity data model.
+type KVBucketResourceIdentityModel struct {
+	AccountId types.String `tfsdk:"account_id"`
+	Bucket    types.String `tfsdk:"bucket"`
+}
+
+func (r *KVBucketResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kv_bucket"
+}
+
+func (r *KVBucketResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a JetStream key value bucket. Import with `<account_id>/<bucket>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Bucket identifier in the form `<account_id>/<bucket>`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "Account the bucket belongs to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"bucket": schema.StringAttribute{
+				MarkdownDescription: "Bucket name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Bucket description",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"history": schema.Int64Attribute{
+				MarkdownDescription: "Number of historical values kept per key",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+			},
+			"ttl_seconds": schema.Int64Attribute{
+				MarkdownDescription: "Time to live of values in seconds, `0` for no expiry",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+			},
+			"max_value_size": schema.Int64Attribute{
+				MarkdownDescription: "Maximum size of a single value in bytes, `-1` for unlimited",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"max_bytes": schema.Int64Attribute{
+				MarkdownDescription: "Maximum size of the bucket in bytes, `-1` for unlimited",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"storage": schema.StringAttribute{
+				MarkdownDescription: "Storage backend: `file` or `memory`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("file"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("file", "memory"),
+				},
+			},
+			"replicas": schema.Int64Attribute{
+				MarkdownDescription: "Number of replicas",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+			},
+			"placement": placementAttribute("bucket"),
+		},
+	}
+}
+
+func (r *KVBucketResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"account_id": identityschema.StringAttribute{
+				Description:       "Account the bucket belongs to",
+				RequiredForImport: true,
+			},
+			"bucket": identityschema.StringAttribute{
+				Description:       "Bucket name",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// ModifyPlan rejects placements the account's system cannot satisfy.
+func (r *KVBucketResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan KVBucketResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() || plan.AccountId.IsUnknown() {
+		return
+	}
+
+	prior, diags := priorPlacement(ctx, req.State, plan.AccountId.ValueString())
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validatePlacement(ctx, r.client, plan.AccountId.ValueString(), plan.Placement, prior)...)
+}
+
+func (r *KVBucketResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *KVBucketResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := r.client.startOperation(ctx, "synadia_kv_bucket", "create")
+	defer func() { span.end(resp.Diagnostics) }()
+
+	var data KVBucketResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	span.setAccount(data.AccountId.ValueString())
+
+	cfg, diags := data.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucket, err := r.client.CreateKVBucket(ctx, data.AccountId.ValueString(), cfg)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "create kv bucket", err, kvBucketAPIFields)
+		return
+	}
+
+	tflog.Trace(ctx, "created a kv bucket", map[string]any{"account_id": data.AccountId.ValueString(), "bucket": bucket.Config.Bucket})
+
+	resp.Diagnostics.Append(data.fromAPI(ctx, data.AccountId.ValueString(), bucket)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+	resp.Diagnostics.Append(saveRevision(ctx, resp.Private, bucket.Revision, bucket.Config)...)
+}
+
+func (r *KVBucketResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := r.client.startOperation(ctx, "synadia_kv_bucket", "read")
+	defer func() { span.end(resp.Diagnostics) }()
+
+	var data KVBucketResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if checkSDKv2State(&resp.Diagnostics, "synadia_kv_bucket", data.AccountId) {
+		return
+	}
+
+	span.setAccount(data.AccountId.ValueString())
+
+	bucket, err := r.client.GetKVBucket(ctx, data.AccountId.ValueString(), data.Bucket.ValueString())
+	if IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read kv bucket, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.fromAPI(ctx, data.AccountId.ValueString(), bucket)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+	resp.Diagnostics.Append(saveRevision(ctx, resp.Private, bucket.Revision, bucket.Config)...)
+}
+
+func (r *KVBucketResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := r.client.startOperation(ctx, "synadia_kv_bucket", "update")
+	defer func() { span.end(resp.Diagnostics) }()
+
+	var data KVBucketResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	span.setAccount(data.AccountId.ValueString())
+
+	cfg, diags := data.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	observed, diags := loadRevision(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucket, err := r.client.UpdateKVBucket(ctx, data.AccountId.ValueString(), cfg, observed.Revision)
+	if IsConflict(err) {
+		current, getErr := r.client.GetKVBucket(ctx, data.AccountId.ValueString(), cfg.Bucket)
+		if getErr != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read changed kv bucket, got error: %s", getErr))
+			return
+		}
+		addConflictError(&resp.Diagnostics, "kv bucket", observed, current.Config)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "update kv bucket", err, kvBucketAPIFields)
+		return
+	}
+
+	resp.Diagnostics.Append(data.fromAPI(ctx, data.AccountId.ValueString(), bucket)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+	resp.Diagnostics.Append(saveRevision(ctx, resp.Private, bucket.Revision, bucket.Config)...)
+}
+
+func (r *KVBucketResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := r.client.startOperation(ctx, "synadia_kv_bucket", "delete")
+	defer func() { span.end(resp.Diagnostics) }()
+
+	var data KVBucketResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	span.setAccount(data.AccountId.ValueString())
+
+	err := r.client.DeleteKVBucket(ctx, data.AccountId.ValueString(), data.Bucket.ValueString())
+	if err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete kv bucket, got error: %s", err))
+		return
+	}
+}
+
+// ImportState accepts either "<account_id>/<bucket>" or an import block identity.
+func (r *KVBucketResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := req.ID
+	if id == "" {
+		var identity KVBucketResourceIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		id = compositeID(identity.AccountId.ValueString(), identity.Bucket.ValueString())
+	}
+
+	parts, err := parseCompositeID(id, 2, "account_id/bucket")
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("bucket"), parts[1])...)
+}
+
+func (m *KVBucketResourceModel) toAPI(ctx context.Context) (*KVBucketConfig, diag.Diagnostics) {
+	cfg := &KVBucketConfig{
+		Bucket:       m.Bucket.ValueString(),
+		Description:  m.Description.ValueString(),
+		History:      m.History.ValueInt64(),
+		TTL:          m.TTLSeconds.ValueInt64() * NanosPerSecond,
+		MaxValueSize: m.MaxValueSize.ValueInt64(),
+		MaxBytes:     m.MaxBytes.ValueInt64(),
+		Storage:      m.Storage.ValueString(),
+		Replicas:     m.Replicas.ValueInt64(),
+	}
+
+	placement, diags := placementToAPI(ctx, m.Placement)
+	cfg.Placement = placement
+	return cfg, diags
+}
+
+func (m *KVBucketResourceModel) fromAPI(ctx context.Context, accountID string, bucket *KVBucket) diag.Diagnostics {
+	cfg := bucket.Config
+
+	m.Id = types.StringValue(compositeID(accountID, cfg.Bucket))
+	m.AccountId = types.StringValue(accountID)
+	m.Bucket = types.StringValue(cfg.Bucket)
+	m.Description = types.StringValue(cfg.Description)
+	m.History = types.Int64Value(cfg.History)
+	m.TTLSeconds = types.Int64Value(cfg.TTL / NanosPerSecond)
+	m.MaxValueSize = types.Int64Value(cfg.MaxValueSize)
+	m.MaxBytes = types.Int64Value(cfg.MaxBytes)
+	m.Storage = types.StringValue(cfg.Storage)
+	m.Replicas = types.Int64Value(cfg.Replicas)
+
+	placement, diags := placementFromAPI(ctx, cfg.Placement)
+	m.Placement = placement
+	return diags
+}
+
+func (m *KVBucketResourceModel) identity() *KVBucketResourceIdentityModel {
+	return &KVBucketResourceIdentityModel{
+		AccountId: m.AccountId,
+		Bucket:    m.Bucket,
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &KVBucketsDataSource{}
+
+func NewKVBucketsDataSource() datasource.DataSource {
+	return &KVBucketsDataSource{}
+}
+
+// KVBucketsDataSource defines the data source implementation.
+type KVBucketsDataSource struct {
+	client *Client
+}
+
+// KVBucketsDataSourceModel describes the data source data model.
+type KVBucketsDataSourceModel struct {
+	AccountId types.String           `tfsdk:"account_id"`
+	NameRegex types.String           `tfsdk:"name_regex"`
+	Storage   types.String           `tfsdk:"storage"`
+	Tags      types.Set              `tfsdk:"tags"`
+	Buckets   []KVBucketSummaryModel `tfsdk:"buckets"`
+}
+
+// KVBucketSummaryModel describes a single bucket entry.
+type KVBucketSummaryModel struct {
+	Id           types.String      `tfsdk:"id"`
+	Bucket       types.String      `tfsdk:"bucket"`
+	Description  types.String      `tfsdk:"description"`
+	History      types.Int64       `tfsdk:"history"`
+	TTLSeconds   types.Int64       `tfsdk:"ttl_seconds"`
+	MaxValueSize types.Int64       `tfsdk:"max_value_size"`
+	MaxBytes     types.Int64       `tfsdk:"max_bytes"`
+	Storage      types.String      `tfsdk:"storage"`
+	Replicas     types.Int64       `tfsdk:"replicas"`
+	Tags         []string          `tfsdk:"tags"`
+	State        *StreamStateModel `tfsdk:"state"`
+}
+
+func kvBucketSummaryFromAPI(accountID string, b *KVBucket) KVBucketSummaryModel {
+	cfg := b.Config
+	return KVBucketSummaryModel{
+		Id:           types.StringValue(compositeID(accountID, cfg.Bucket)),
+		Bucket:       types.StringValue(cfg.Bucket),
+		Description:  types.StringValue(cfg.Description),
+		History:      types.Int64Value(cfg.History),
+		TTLSeconds:   types.Int64Value(cfg.TTL / NanosPerSecond),
+		MaxValueSize: types.Int64Value(cfg.MaxValueSize),
+		MaxBytes:     types.Int64Value(cfg.MaxBytes),
+		Storage:      types.StringValue(cfg.Storage),
+		Replicas:     types.Int64Value(cfg.Replicas),
+		Tags:         append([]string{}, b.Tags...),
+		State:        streamStateFromAPI(b.State),
+	}
+}
+
+func (d *KVBucketsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kv_buckets"
+}
+
+func (d *KVBucketsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := map[string]schema.Attribute{
+		"account_id": schema.StringAttribute{
+			MarkdownDescription: "Account to list buckets in",
+			Required:            true,
+		},
+		"buckets": schema.ListNestedAttribute{
+			MarkdownDescription: "Matching buckets, ordered as returned by the control plane",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						MarkdownDescription: "Bucket identifier in the form `<account_id>/<bucket>`",
+						Computed:            true,
+					},
+					"bucket": schema.StringAttribute{
+						MarkdownDescription: "Bucket name",
+						Computed:            true,
+					},
+					"description": schema.StringAttribute{
+						MarkdownDescription: "Bucket description",
+						Computed:            true,
+					},
+					"history": schema.Int64Attribute{
+						MarkdownDescription: "Number of historical values kept per key",
+						Computed:            true,
+					},
+					"ttl_seconds": schema.Int64Attribute{
+						MarkdownDescription: "Time to live of values in seconds, `0` for no expiry",
+						Computed:            true,
+					},
+					"max_value_size": schema.Int64Attribute{
+						MarkdownDescription: "Maximum size of a single value in bytes, `-1` for unlimited",
+						Computed:            true,
+					},
+					"max_bytes": schema.Int64Attribute{
+						MarkdownDescription: "Maximum size of the bucket in bytes, `-1` for unlimited",
+						Computed:            true,
+					},
+					"storage": schema.StringAttribute{
+						MarkdownDescription: "Storage backend",
+						Computed:            true,
+					},
+					"replicas": schema.Int64Attribute{
+						MarkdownDescription: "Number of replicas",
+						Computed:            true,
+					},
+					"tags": schema.ListAttribute{
+						MarkdownDescription: "Tags of the bucket",
+						ElementType:         types.StringType,
+						Computed:            true,
+					},
+					"state": streamStateAttribute("bucket"),
+				},
+			},
+		},
+	}
+	maps.Copy(attrs, assetFilterAttributes("buckets", false))
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the JetStream key value buckets of an account together with their runtime state.",
+
+		Attributes: attrs,
+	}
+}
+
+func (d *KVBucketsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *KVBucketsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data KVBucketsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := newAssetFilter(ctx, data.NameRegex, types.StringNull(), data.Storage, data.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountID := data.AccountId.ValueString()
+
+	buckets, err := d.client.ListKVBuckets(ctx, accountID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list KV buckets, got error: %s", err))
+		return
+	}
+
+	data.Buckets = []KVBucketSummaryModel{}
+	for i := range buckets {
+		b := &buckets[i]
+		if !filter.matches(b.Config.Bucket, nil, b.Config.Storage, b.Tags) {
+			continue
+		}
+		data.Buckets = append(data.Buckets, kvBucketSummaryFromAPI(accountID, b))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
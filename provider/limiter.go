@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"io"
+	"math"
+	"net/http"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// defaultMaxConcurrentRequests matches Terraform's default -parallelism.
+const defaultMaxConcurrentRequests = 10
+
+// limitTransport caps the number of in-flight requests and, when rps is
+// positive, the request rate with a token bucket. One limitTransport is shared
+// by every resource and data source of a provider instance, so the limits
+// apply to the provider as a whole rather than per object.
+//
+// A request holds its concurrency slot until its response body is closed.
+type limitTransport struct {
+	base    http.RoundTripper
+	slots   chan struct{}
+	limiter *rate.Limiter
+}
+
+func newLimitTransport(base http.RoundTripper, maxConcurrent int, rps float64) *limitTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if maxConcurrent < 1 {
+		maxConcurrent = defaultMaxConcurrentRequests
+	}
+
+	limiter := rate.NewLimiter(rate.Inf, 0)
+	if rps > 0 {
+		limiter = rate.NewLimiter(rate.Limit(rps), max(1, int(math.Ceil(rps))))
+	}
+
+	return &limitTransport{
+		base:    base,
+		slots:   make(chan struct{}, maxConcurrent),
+		limiter: limiter,
+	}
+}
+
+func (t *limitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	if err := t.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	select {
+	case t.slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		<-t.slots
+		return nil, err
+	}
+
+	resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: func() { <-t.slots }}
+	return resp, nil
+}
+
+// releaseOnClose frees a concurrency slot the first time the body is closed.
+type releaseOnClose struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releaseOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
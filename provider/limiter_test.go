@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// inFlightTransport answers every request after a short delay and records the
+// highest number of requests in flight, counting a request until its
+// response body is closed.
+type inFlightTransport struct {
+	inFlight atomic.Int32
+	peak     atomic.Int32
+}
+
+func (t *inFlightTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	n := t.inFlight.Add(1)
+	for {
+		peak := t.peak.Load()
+		if n <= peak || t.peak.CompareAndSwap(peak, n) {
+			break
+		}
+	}
+
+	time.Sleep(5 * time.Millisecond)
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       &countedBody{Reader: strings.NewReader("{}"), inFlight: &t.inFlight},
+	}, nil
+}
+
+type countedBody struct {
+	io.Reader
+	inFlight *atomic.Int32
+}
+
+func (b *countedBody) Close() error {
+	b.inFlight.Add(-1)
+	return nil
+}
+
+func TestLimitTransport_MaxConcurrent(t *testing.T) {
+	const maxConcurrent = 3
+
+	base := &inFlightTransport{}
+	client := &http.Client{Transport: newLimitTransport(base, maxConcurrent, 0)}
+
+	var wg sync.WaitGroup
+	for range 30 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get("http://synadia.invalid/accounts")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if peak := base.peak.Load(); peak > maxConcurrent {
+		t.Errorf("%d requests in flight, want at most %d", peak, maxConcurrent)
+	}
+	if n := base.inFlight.Load(); n != 0 {
+		t.Errorf("%d requests still in flight", n)
+	}
+}
+
+func TestLimitTransport_WaitForSlotCancelled(t *testing.T) {
+	client := &http.Client{Transport: newLimitTransport(&inFlightTransport{}, 1, 0)}
+
+	// The first response holds the only slot until its body is closed.
+	held, err := client.Get("http://synadia.invalid/accounts")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://synadia.invalid/accounts", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Do(req); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got error %v, want the wait for a slot to end with the context", err)
+	}
+
+	held.Body.Close()
+
+	resp, err := client.Get("http://synadia.invalid/accounts")
+	if err != nil {
+		t.Fatalf("slot not released: %s", err)
+	}
+	resp.Body.Close()
+}
+
+func TestLimitTransport_RequestsPerSecond(t *testing.T) {
+	client := &http.Client{Transport: newLimitTransport(&inFlightTransport{}, 0, 1)}
+
+	// The bucket starts full with a single token.
+	resp, err := client.Get("http://synadia.invalid/accounts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://synadia.invalid/accounts", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	if _, err := client.Do(req); err == nil {
+		t.Fatal("second request within a second was not rate limited")
+	}
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Errorf("waited %s for a token that cannot arrive before the deadline", elapsed)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// List resources back `list` blocks in `terraform query`. They all accept the
+// same optional name_prefix and tag filters, which are applied client side to
+// whatever the control plane returns for the parent object.
+
+// JetStream implements KV and object buckets on top of streams with these
+// prefixes; list results and the import generator report them as buckets
+// rather than plain streams.
+const (
+	KVStreamPrefix     = "KV_"
+	ObjectStreamPrefix = "OBJ_"
+)
+
+// IsBucketStream reports whether the stream called name backs a KV or object
+// bucket.
+func IsBucketStream(name string) bool {
+	return strings.HasPrefix(name, KVStreamPrefix) || strings.HasPrefix(name, ObjectStreamPrefix)
+}
+
+// listFilter matches objects against the name_prefix and tag filters. Null
+// filters match everything.
+type listFilter struct {
+	namePrefix string
+	tag        string
+}
+
+func newListFilter(namePrefix, tag types.String) listFilter {
+	return listFilter{
+		namePrefix: namePrefix.ValueString(),
+		tag:        tag.ValueString(),
+	}
+}
+
+func (f listFilter) matches(name string, tags []string) bool {
+	if !strings.HasPrefix(name, f.namePrefix) {
+		return false
+	}
+	return f.tag == "" || slices.Contains(tags, f.tag)
+}
+
+func namePrefixAttribute(kind string) listschema.StringAttribute {
+	return listschema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("Only list %s whose name starts with this prefix", kind),
+		Optional:            true,
+	}
+}
+
+func tagAttribute(kind string) listschema.StringAttribute {
+	return listschema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("Only list %s carrying this tag", kind),
+		Optional:            true,
+	}
+}
+
+// listResults streams one result per item and stops once the request limit is
+// reached. fill sets the display name, identity and, when the request asks for
+// it, the full resource state of each result.
+func listResults[T any](ctx context.Context, req list.ListRequest, items []T, fill func(item *T, result *list.ListResult)) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		for i := range items {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			result := req.NewListResult(ctx)
+			fill(&items[i], &result)
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &NatsUserListResource{}
+var _ list.ListResourceWithConfigure = &NatsUserListResource{}
+
+func NewNatsUserListResource() list.ListResource {
+	return &NatsUserListResource{}
+}
+
+// NatsUserListResource defines the list resource implementation.
+type NatsUserListResource struct {
+	client *Client
+}
+
+// NatsUserListResourceModel describes the list resource config data model.
+type NatsUserListResourceModel struct {
+	AccountId  types.String `tfsdk:"account_id"`
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	Tag        types.String `tfsdk:"tag"`
+}
+
+func (r *NatsUserListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nats_user"
+}
+
+func (r *NatsUserListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the NATS users issued under an account.",
+
+		Attributes: map[string]listschema.Attribute{
+			"account_id": listschema.StringAttribute{
+				MarkdownDescription: "Account to list NATS users in",
+				Required:            true,
+			},
+			"name_prefix": namePrefixAttribute("NATS users"),
+			"tag":         tagAttribute("NATS users"),
+		},
+	}
+}
+
+func (r *NatsUserListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NatsUserListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config NatsUserListResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	users, err := r.client.ListNatsUsers(ctx, config.AccountId.ValueString(), ListFilter{
+		Name: config.NamePrefix.ValueString(),
+		Tag:  config.Tag.ValueString(),
+	})
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list NATS users, got error: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	filter := newListFilter(config.NamePrefix, config.Tag)
+	matched := make([]NatsUser, 0, len(users))
+	for _, u := range users {
+		if filter.matches(u.Name, u.Tags) {
+			matched = append(matched, u)
+		}
+	}
+
+	stream.Results = listResults(ctx, req, matched, func(u *NatsUser, result *list.ListResult) {
+		var data NatsUserResourceModel
+		data.fromAPI(u)
+		result.DisplayName = u.Name
+		result.Diagnostics.Append(result.Identity.Set(ctx, data.identity())...)
+		if req.IncludeResource {
+			result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+		}
+	})
+}
@@ -0,0 +1,369 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nats-io/nkeys"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NatsUserResource{}
+var _ resource.ResourceWithImportState = &NatsUserResource{}
+var _ resource.ResourceWithIdentity = &NatsUserResource{}
+var _ resource.ResourceWithValidateConfig = &NatsUserResource{}
+
+func NewNatsUserResource() resource.Resource {
+	return &NatsUserResource{}
+}
+
+// NatsUserResource defines the resource implementation.
+type NatsUserResource struct {
+	client *Client
+}
+
+// NatsUserResourceModel describes the resource data model.
+type NatsUserResourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	AccountId          types.String `tfsdk:"account_id"`
+	Name               types.String `tfsdk:"name"`
+	PublicKey          types.String `tfsdk:"public_key"`
+	PublicKeyWO        types.String `tfsdk:"public_key_wo"`
+	PublicKeyWOVersion types.Int64  `tfsdk:"public_key_wo_version"`
+}
+
+// natsUserAPIFields maps NATS user request fields to schema attributes.
+var natsUserAPIFields = apiFieldPaths{
+	"name":            path.Root("name"),
+	"user_public_key": path.Root("public_key_wo"),
+}
+
+// NatsUserResourceIdentityModel describes the resource identity data model.
+type NatsUserResourceIdentityModel struct {
+	Id types.String `tfsdk:"id"`
+}
+
+func (r *NatsUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nats_user"
+}
+
+func (r *NatsUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a NATS user issued under an account. Import with `<nats_user_id>` or `<account_id>/<name>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "NATS user identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "Account the user is issued under",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "User name",
+				Required:            true,
+			},
+			"public_key": schema.StringAttribute{
+				MarkdownDescription: "User NKey public key",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"public_key_wo": schema.StringAttribute{
+				MarkdownDescription: "User NKey public key to issue the user against instead of a server generated " +
+					"one, e.g. from the `synadia_nkey` ephemeral resource. Write-only: it is only read on create " +
+					"and never stored. Change `public_key_wo_version` to re-create the user with a new key.",
+				Optional:  true,
+				WriteOnly: true,
+			},
+			"public_key_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Arbitrary number to change whenever `public_key_wo` should be applied again. " +
+					"Changing it re-creates the user.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *NatsUserResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "NATS user identifier",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *NatsUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks public_key_wo during plan when its value is known.
+func (r *NatsUserResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var publicKey types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("public_key_wo"), &publicKey)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateUserPublicKey(&resp.Diagnostics, publicKey)
+}
+
+func (r *NatsUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := r.client.startOperation(ctx, "synadia_nats_user", "create")
+	defer func() { span.end(resp.Diagnostics) }()
+
+	var data NatsUserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	span.setAccount(data.AccountId.ValueString())
+
+	// Write-only values are only available from the configuration.
+	var publicKey types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("public_key_wo"), &publicKey)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only values from ephemeral resources may still be unknown when
+	// the configuration is validated during plan, so check again here.
+	validateUserPublicKey(&resp.Diagnostics, publicKey)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.client.CreateNatsUser(ctx, data.AccountId.ValueString(), &NatsUserCreateRequest{
+		Name:      data.Name.ValueString(),
+		PublicKey: publicKey.ValueString(),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "create NATS user", err, natsUserAPIFields)
+		return
+	}
+
+	tflog.Trace(ctx, "created a NATS user", map[string]any{"id": user.ID})
+
+	data.fromAPI(user)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+	resp.Diagnostics.Append(saveRevision(ctx, resp.Private, user.Revision, user.managed())...)
+
+	// The user is saved to state either way, so an error here taints it and
+	// the next apply re-creates it.
+	if !publicKey.IsNull() && user.PublicKey != publicKey.ValueString() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("public_key_wo"),
+			"NATS User Public Key Mismatch",
+			fmt.Sprintf("The user was requested with public key %s but was issued with %s. "+
+				"The user has been marked as tainted.", publicKey.ValueString(), user.PublicKey),
+		)
+	}
+}
+
+func (r *NatsUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := r.client.startOperation(ctx, "synadia_nats_user", "read")
+	defer func() { span.end(resp.Diagnostics) }()
+
+	var data NatsUserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	span.setAccount(data.AccountId.ValueString())
+
+	user, err := r.client.GetNatsUser(ctx, data.Id.ValueString())
+	if IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read NATS user, got error: %s", err))
+		return
+	}
+
+	data.fromAPI(user)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+	resp.Diagnostics.Append(saveRevision(ctx, resp.Private, user.Revision, user.managed())...)
+}
+
+func (r *NatsUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := r.client.startOperation(ctx, "synadia_nats_user", "update")
+	defer func() { span.end(resp.Diagnostics) }()
+
+	var data NatsUserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	span.setAccount(data.AccountId.ValueString())
+
+	observed, diags := loadRevision(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.client.UpdateNatsUser(ctx, data.AccountId.ValueString(), data.Id.ValueString(), &NatsUserUpdateRequest{
+		Name: data.Name.ValueString(),
+	}, observed.Revision)
+	if IsConflict(err) {
+		current, getErr := r.client.GetNatsUser(ctx, data.Id.ValueString())
+		if getErr != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read changed NATS user, got error: %s", getErr))
+			return
+		}
+		addConflictError(&resp.Diagnostics, "NATS user", observed, current.managed())
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "update NATS user", err, natsUserAPIFields)
+		return
+	}
+
+	data.fromAPI(user)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+	resp.Diagnostics.Append(saveRevision(ctx, resp.Private, user.Revision, user.managed())...)
+}
+
+func (r *NatsUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := r.client.startOperation(ctx, "synadia_nats_user", "delete")
+	defer func() { span.end(resp.Diagnostics) }()
+
+	var data NatsUserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	span.setAccount(data.AccountId.ValueString())
+
+	err := r.client.DeleteNatsUser(ctx, data.AccountId.ValueString(), data.Id.ValueString())
+	if err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete NATS user, got error: %s", err))
+		return
+	}
+}
+
+// ImportState accepts a NATS user ID, "<account_id>/<name>" or an import
+// block identity.
+func (r *NatsUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	accountID, name, ok := strings.Cut(req.ID, "/")
+	if !ok {
+		resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+		return
+	}
+	if accountID == "" || name == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: nats_user_id or account_id/name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	users, err := r.client.ListNatsUsers(ctx, accountID, ListFilter{Name: name})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list NATS users, got error: %s", err))
+		return
+	}
+
+	for _, u := range users {
+		if u.Name == name {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), u.ID)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError(
+		"NATS User Not Found",
+		fmt.Sprintf("No NATS user named %q exists in account %q.", name, accountID),
+	)
+}
+
+func (m *NatsUserResourceModel) fromAPI(user *NatsUser) {
+	m.Id = types.StringValue(user.ID)
+	m.AccountId = types.StringValue(user.AccountID)
+	m.Name = types.StringValue(user.Name)
+	m.PublicKey = types.StringValue(user.PublicKey)
+}
+
+func (m *NatsUserResourceModel) identity() *NatsUserResourceIdentityModel {
+	return &NatsUserResourceIdentityModel{Id: m.Id}
+}
+
+// validateUserPublicKey reports an error on public_key_wo unless publicKey is
+// null, unknown or a user NKey public key.
+func validateUserPublicKey(diags *diag.Diagnostics, publicKey types.String) {
+	if publicKey.IsNull() || publicKey.IsUnknown() || nkeys.IsValidPublicUserKey(publicKey.ValueString()) {
+		return
+	}
+	diags.AddAttributeError(
+		path.Root("public_key_wo"),
+		"Invalid NKey Public Key",
+		"Expected a user NKey public key starting with \"U\".",
+	)
+}
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nats-io/nkeys"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &NKeyEphemeralResource{}
+
+func NewNKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &NKeyEphemeralResource{}
+}
+
+// NKeyEphemeralResource generates an NKey pair locally. Nothing is sent to
+// the control plane, and as an ephemeral resource the seed never reaches plan
+// or state.
+type NKeyEphemeralResource struct{}
+
+// NKeyEphemeralResourceModel describes the ephemeral resource data model.
+type NKeyEphemeralResourceModel struct {
+	Type      types.String `tfsdk:"type"`
+	PublicKey types.String `tfsdk:"public_key"`
+	Seed      types.String `tfsdk:"seed"`
+}
+
+// nkeyPrefixes maps the supported key types to their NKey prefix.
+var nkeyPrefixes = map[string]nkeys.PrefixByte{
+	"user":     nkeys.PrefixByteUser,
+	"account":  nkeys.PrefixByteAccount,
+	"operator": nkeys.PrefixByteOperator,
+}
+
+func (r *NKeyEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nkey"
+}
+
+func (r *NKeyEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates an NKey pair locally. Pass `public_key` to a write-only attribute such as " +
+			"`synadia_nats_user.public_key_wo` and store `seed` in a secrets manager in the same apply; neither is " +
+			"written to plan or state. A new pair is generated on every run.",
+
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Key type: `user`, `account` or `operator`. Defaults to `user`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("user", "account", "operator"),
+				},
+			},
+			"public_key": schema.StringAttribute{
+				MarkdownDescription: "Public key",
+				Computed:            true,
+			},
+			"seed": schema.StringAttribute{
+				MarkdownDescription: "Private seed",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *NKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data NKeyEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Type.IsNull() {
+		data.Type = types.StringValue("user")
+	}
+
+	kp, err := nkeys.CreatePair(nkeyPrefixes[data.Type.ValueString()])
+	if err != nil {
+		resp.Diagnostics.AddError("NKey Error", fmt.Sprintf("Unable to generate %s NKey, got error: %s", data.Type.ValueString(), err))
+		return
+	}
+	defer kp.Wipe()
+
+	pub, err := kp.PublicKey()
+	if err != nil {
+		resp.Diagnostics.AddError("NKey Error", fmt.Sprintf("Unable to read public key, got error: %s", err))
+		return
+	}
+	seed, err := kp.Seed()
+	if err != nil {
+		resp.Diagnostics.AddError("NKey Error", fmt.Sprintf("Unable to read seed, got error: %s", err))
+		return
+	}
+
+	data.PublicKey = types.StringValue(pub)
+	data.Seed = types.StringValue(string(seed))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
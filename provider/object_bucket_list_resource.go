@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &ObjectBucketListResource{}
+var _ list.ListResourceWithConfigure = &ObjectBucketListResource{}
+
+func NewObjectBucketListResource() list.ListResource {
+	return &ObjectBucketListResource{}
+}
+
+// ObjectBucketListResource defines the list resource implementation.
+type ObjectBucketListResource struct {
+	client *Client
+}
+
+// ObjectBucketListResourceModel describes the list resource config data model.
+type ObjectBucketListResourceModel struct {
+	AccountId  types.String `tfsdk:"account_id"`
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	Tag        types.String `tfsdk:"tag"`
+}
+
+func (r *ObjectBucketListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_bucket"
+}
+
+func (r *ObjectBucketListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the JetStream object store buckets of an account.",
+
+		Attributes: map[string]listschema.Attribute{
+			"account_id": listschema.StringAttribute{
+				MarkdownDescription: "Account to list buckets in",
+				Required:            true,
+			},
+			"name_prefix": namePrefixAttribute("buckets"),
+			"tag":         tagAttribute("buckets"),
+		},
+	}
+}
+
+func (r *ObjectBucketListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ObjectBucketListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config ObjectBucketListResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	accountID := config.AccountId.ValueString()
+
+	buckets, err := r.client.ListObjectBuckets(ctx, accountID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list object buckets, got error: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	filter := newListFilter(config.NamePrefix, config.Tag)
+	matched := make([]ObjectBucket, 0, len(buckets))
+	for _, b := range buckets {
+		if filter.matches(b.Config.Bucket, b.Tags) {
+			matched = append(matched, b)
+		}
+	}
+
+	stream.Results = listResults(ctx, req, matched, func(b *ObjectBucket, result *list.ListResult) {
+		var data ObjectBucketResourceModel
+		result.DisplayName = b.Config.Bucket
+		result.Diagnostics.Append(data.fromAPI(ctx, accountID, b)...)
+		result.Diagnostics.Append(result.Identity.Set(ctx, data.identity())...)
+		if req.IncludeResource {
+			result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+		}
+	})
+}
@@ -0,0 +1,384 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ObjectBucketResource{}
+var _ resource.ResourceWithImportState = &ObjectBucketResource{}
+var _ resource.ResourceWithIdentity = &ObjectBucketResource{}
+var _ resource.ResourceWithModifyPlan = &ObjectBucketResource{}
+
+func NewObjectBucketResource() resource.Resource {
+	return &ObjectBucketResource{}
+}
+
+// ObjectBucketResource defines the resource implementation.
+type ObjectBucketResource struct {
+	client *Client
+}
+
+// ObjectBucketResourceModel describes the resource data model.
+type ObjectBucketResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	AccountId   types.String `tfsdk:"account_id"`
+	Bucket      types.String `tfsdk:"bucket"`
+	Description types.String `tfsdk:"description"`
+	MaxBytes    types.Int64  `tfsdk:"max_bytes"`
+	Storage     types.String `tfsdk:"storage"`
+	Replicas    types.Int64  `tfsdk:"replicas"`
+	Placement   types.Object `tfsdk:"placement"`
+}
+
+// objectBucketAPIFields maps object bucket request fields to schema attributes.
+var objectBucketAPIFields = apiFieldPaths{
+	"bucket":      path.Root("bucket"),
+	"description": path.Root("description"),
+	"max_bytes":   path.Root("max_bytes"),
+	"storage":     path.Root("storage"),
+	"replicas":    path.Root("replicas"),
+}.withPlacement()
+
+// ObjectBucketResourceIdentityModel describes the resource identity data model.
+type ObjectBucketResourceIdentityModel struct {
+	AccountId types.String `tfsdk:"account_id"`
+	Bucket    types.String `tfsdk:"bucket"`
+}
+
+func (r *ObjectBucketResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_bucket"
+}
+
+func (r *ObjectBucketResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a JetStream object store bucket. Import with `<account_id>/<bucket>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Bucket identifier in the form `<account_id>/<bucket>`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "Account the bucket belongs to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"bucket": schema.StringAttribute{
+				MarkdownDescription: "Bucket name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Bucket description",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"max_bytes": schema.Int64Attribute{
+				MarkdownDescription: "Maximum size of the bucket in bytes, `-1` for unlimited",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"storage": schema.StringAttribute{
+				MarkdownDescription: "Storage backend: `file` or `memory`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("file"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("file", "memory"),
+				},
+			},
+			"replicas": schema.Int64Attribute{
+				MarkdownDescription: "Number of replicas",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+			},
+			"placement": placementAttribute("bucket"),
+		},
+	}
+}
+
+func (r *ObjectBucketResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"account_id": identityschema.StringAttribute{
+				Description:       "Account the bucket belongs to",
+				RequiredForImport: true,
+			},
+			"bucket": identityschema.StringAttribute{
+				Description:       "Bucket name",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// ModifyPlan rejects placements the account's system cannot satisfy.
+func (r *ObjectBucketResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan ObjectBucketResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() || plan.AccountId.IsUnknown() {
+		return
+	}
+
+	prior, diags := priorPlacement(ctx, req.State, plan.AccountId.ValueString())
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validatePlacement(ctx, r.client, plan.AccountId.ValueString(), plan.Placement, prior)...)
+}
+
+func (r *ObjectBucketResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ObjectBucketResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := r.client.startOperation(ctx, "synadia_object_bucket", "create")
+	defer func() { span.end(resp.Diagnostics) }()
+
+	var data ObjectBucketResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	span.setAccount(data.AccountId.ValueString())
+
+	cfg, diags := data.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucket, err := r.client.CreateObjectBucket(ctx, data.AccountId.ValueString(), cfg)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "create object bucket", err, objectBucketAPIFields)
+		return
+	}
+
+	tflog.Trace(ctx, "created an object bucket", map[string]any{"account_id": data.AccountId.ValueString(), "bucket": bucket.Config.Bucket})
+
+	resp.Diagnostics.Append(data.fromAPI(ctx, data.AccountId.ValueString(), bucket)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+	resp.Diagnostics.Append(saveRevision(ctx, resp.Private, bucket.Revision, bucket.Config)...)
+}
+
+func (r *ObjectBucketResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := r.client.startOperation(ctx, "synadia_object_bucket", "read")
+	defer func() { span.end(resp.Diagnostics) }()
+
+	var data ObjectBucketResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	span.setAccount(data.AccountId.ValueString())
+
+	bucket, err := r.client.GetObjectBucket(ctx, data.AccountId.ValueString(), data.Bucket.ValueString())
+	if IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read object bucket, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.fromAPI(ctx, data.AccountId.ValueString(), bucket)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+	resp.Diagnostics.Append(saveRevision(ctx, resp.Private, bucket.Revision, bucket.Config)...)
+}
+
+func (r *ObjectBucketResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := r.client.startOperation(ctx, "synadia_object_bucket", "update")
+	defer func() { span.end(resp.Diagnostics) }()
+
+	var data ObjectBucketResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	span.setAccount(data.AccountId.ValueString())
+
+	cfg, diags := data.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	observed, diags := loadRevision(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucket, err := r.client.UpdateObjectBucket(ctx, data.AccountId.ValueString(), cfg, observed.Revision)
+	if IsConflict(err) {
+		current, getErr := r.client.GetObjectBucket(ctx, data.AccountId.ValueString(), cfg.Bucket)
+		if getErr != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read changed object bucket, got error: %s", getErr))
+			return
+		}
+		addConflictError(&resp.Diagnostics, "object bucket", observed, current.Config)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "update object bucket", err, objectBucketAPIFields)
+		return
+	}
+
+	resp.Diagnostics.Append(data.fromAPI(ctx, data.AccountId.ValueString(), bucket)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+	resp.Diagnostics.Append(saveRevision(ctx, resp.Private, bucket.Revision, bucket.Config)...)
+}
+
+func (r *ObjectBucketResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := r.client.startOperation(ctx, "synadia_object_bucket", "delete")
+	defer func() { span.end(resp.Diagnostics) }()
+
+	var data ObjectBucketResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	span.setAccount(data.AccountId.ValueString())
+
+	err := r.client.DeleteObjectBucket(ctx, data.AccountId.ValueString(), data.Bucket.ValueString())
+	if err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete object bucket, got error: %s", err))
+		return
+	}
+}
+
+// ImportState accepts either "<account_id>/<bucket>" or an import block identity.
+func (r *ObjectBucketResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := req.ID
+	if id == "" {
+		var identity ObjectBucketResourceIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		id = compositeID(identity.AccountId.ValueString(), identity.Bucket.ValueString())
+	}
+
+	parts, err := parseCompositeID(id, 2, "account_id/bucket")
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("bucket"), parts[1])...)
+}
+
+func (m *ObjectBucketResourceModel) toAPI(ctx context.Context) (*ObjectBucketConfig, diag.Diagnostics) {
+	cfg := &ObjectBucketConfig{
+		Bucket:      m.Bucket.ValueString(),
+		Description: m.Description.ValueString(),
+		MaxBytes:    m.MaxBytes.ValueInt64(),
+		Storage:     m.Storage.ValueString(),
+		Replicas:    m.Replicas.ValueInt64(),
+	}
+
+	placement, diags := placementToAPI(ctx, m.Placement)
+	cfg.Placement = placement
+	return cfg, diags
+}
+
+func (m *ObjectBucketResourceModel) fromAPI(ctx context.Context, accountID string, bucket *ObjectBucket) diag.Diagnostics {
+	cfg := bucket.Config
+
+	m.Id = types.StringValue(compositeID(accountID, cfg.Bucket))
+	m.AccountId = types.StringValue(accountID)
+	m.Bucket = types.StringValue(cfg.Bucket)
+	m.Description = types.StringValue(cfg.Description)
+	m.MaxBytes = types.Int64Value(cfg.MaxBytes)
+	m.Storage = types.StringValue(cfg.Storage)
+	m.Replicas = types.Int64Value(cfg.Replicas)
+
+	placement, diags := placementFromAPI(ctx, cfg.Placement)
+	m.Placement = placement
+	return diags
+}
+
+func (m *ObjectBucketResourceModel) identity() *ObjectBucketResourceIdentityModel {
+	return &ObjectBucketResourceIdentityModel{
+		AccountId: m.AccountId,
+		Bucket:    m.Bucket,
+	}
+}
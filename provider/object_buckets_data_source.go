@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ObjectBucketsDataSource{}
+
+func NewObjectBucketsDataSource() datasource.DataSource {
+	return &ObjectBucketsDataSource{}
+}
+
+// ObjectBucketsDataSource defines the data source implementation.
+type ObjectBucketsDataSource struct {
+	client *Client
+}
+
+// ObjectBucketsDataSourceModel describes the data source data model.
+type ObjectBucketsDataSourceModel struct {
+	AccountId types.String               `tfsdk:"account_id"`
+	NameRegex types.String               `tfsdk:"name_regex"`
+	Storage   types.String               `tfsdk:"storage"`
+	Tags      types.Set                  `tfsdk:"tags"`
+	Buckets   []ObjectBucketSummaryModel `tfsdk:"buckets"`
+}
+
+// ObjectBucketSummaryModel describes a single bucket entry.
+type ObjectBucketSummaryModel struct {
+	Id          types.String      `tfsdk:"id"`
+	Bucket      types.String      `tfsdk:"bucket"`
+	Description types.String      `tfsdk:"description"`
+	MaxBytes    types.Int64       `tfsdk:"max_bytes"`
+	Storage     types.String      `tfsdk:"storage"`
+	Replicas    types.Int64       `tfsdk:"replicas"`
+	Tags        []string          `tfsdk:"tags"`
+	State       *StreamStateModel `tfsdk:"state"`
+}
+
+func objectBucketSummaryFromAPI(accountID string, b *ObjectBucket) ObjectBucketSummaryModel {
+	cfg := b.Config
+	return ObjectBucketSummaryModel{
+		Id:          types.StringValue(compositeID(accountID, cfg.Bucket)),
+		Bucket:      types.StringValue(cfg.Bucket),
+		Description: types.StringValue(cfg.Description),
+		MaxBytes:    types.Int64Value(cfg.MaxBytes),
+		Storage:     types.StringValue(cfg.Storage),
+		Replicas:    types.Int64Value(cfg.Replicas),
+		Tags:        append([]string{}, b.Tags...),
+		State:       streamStateFromAPI(b.State),
+	}
+}
+
+func (d *ObjectBucketsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_buckets"
+}
+
+func (d *ObjectBucketsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := map[string]schema.Attribute{
+		"account_id": schema.StringAttribute{
+			MarkdownDescription: "Account to list buckets in",
+			Required:            true,
+		},
+		"buckets": schema.ListNestedAttribute{
+			MarkdownDescription: "Matching buckets, ordered as returned by the control plane",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						MarkdownDescription: "Bucket identifier in the form `<account_id>/<bucket>`",
+						Computed:            true,
+					},
+					"bucket": schema.StringAttribute{
+						MarkdownDescription: "Bucket name",
+						Computed:            true,
+					},
+					"description": schema.StringAttribute{
+						MarkdownDescription: "Bucket description",
+						Computed:            true,
+					},
+					"max_bytes": schema.Int64Attribute{
+						MarkdownDescription: "Maximum size of the bucket in bytes, `-1` for unlimited",
+						Computed:            true,
+					},
+					"storage": schema.StringAttribute{
+						MarkdownDescription: "Storage backend",
+						Computed:            true,
+					},
+					"replicas": schema.Int64Attribute{
+						MarkdownDescription: "Number of replicas",
+						Computed:            true,
+					},
+					"tags": schema.ListAttribute{
+						MarkdownDescription: "Tags of the bucket",
+						ElementType:         types.StringType,
+						Computed:            true,
+					},
+					"state": streamStateAttribute("bucket"),
+				},
+			},
+		},
+	}
+	maps.Copy(attrs, assetFilterAttributes("buckets", false))
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the JetStream object store buckets of an account together with their runtime state.",
+
+		Attributes: attrs,
+	}
+}
+
+func (d *ObjectBucketsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ObjectBucketsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ObjectBucketsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := newAssetFilter(ctx, data.NameRegex, types.StringNull(), data.Storage, data.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountID := data.AccountId.ValueString()
+
+	buckets, err := d.client.ListObjectBuckets(ctx, accountID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list object buckets, got error: %s", err))
+		return
+	}
+
+	data.Buckets = []ObjectBucketSummaryModel{}
+	for i := range buckets {
+		b := &buckets[i]
+		if !filter.matches(b.Config.Bucket, nil, b.Config.Storage, b.Tags) {
+			continue
+		}
+		data.Buckets = append(data.Buckets, objectBucketSummaryFromAPI(accountID, b))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// PlacementModel describes the placement attribute shared by streams, KV and
+// object buckets.
+type PlacementModel struct {
+	Cluster types.String `tfsdk:"cluster"`
+	Tags    types.Set    `tfsdk:"tags"`
+}
+
+var placementAttrTypes = map[string]attr.Type{
+	"cluster": types.StringType,
+	"tags":    types.SetType{ElemType: types.StringType},
+}
+
+// placementAttribute returns the schema of the placement attribute. The
+// values are checked against the system's placement options at plan time by
+// validatePlacement.
+func placementAttribute(asset string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: fmt.Sprintf("Where to place the %s's replicas. Valid values are listed by the "+
+			"`synadia_jetstream_placement_options` data source.", asset),
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				MarkdownDescription: "Cluster to place replicas in",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("tags")),
+				},
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Server tags every replica's server must carry",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// placementToAPI converts a planned placement attribute. A null placement
+// yields nil.
+func placementToAPI(ctx context.Context, obj types.Object) (*Placement, diag.Diagnostics) {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil, nil
+	}
+
+	var m PlacementModel
+	diags := obj.As(ctx, &m, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	p := &Placement{Cluster: m.Cluster.ValueString()}
+	diags.Append(m.Tags.ElementsAs(ctx, &p.Tags, false)...)
+	return p, diags
+}
+
+// placementFromAPI converts an asset's placement, returning a null object
+// when none is set.
+func placementFromAPI(ctx context.Context, p *Placement) (types.Object, diag.Diagnostics) {
+	if p == nil || (p.Cluster == "" && len(p.Tags) == 0) {
+		return types.ObjectNull(placementAttrTypes), nil
+	}
+
+	tags := types.SetNull(types.StringType)
+	var diags diag.Diagnostics
+	if len(p.Tags) > 0 {
+		tags, diags = types.SetValueFrom(ctx, types.StringType, p.Tags)
+	}
+
+	obj, objDiags := types.ObjectValueFrom(ctx, placementAttrTypes, PlacementModel{
+		Cluster: optionalString(p.Cluster),
+		Tags:    tags,
+	})
+	diags.Append(objDiags...)
+	return obj, diags
+}
+
+// validatePlacement rejects a planned placement whose cluster or tags are not
+// among the placement options of the account's system. A placement unchanged
+// from prior, the placement in state, is not checked again.
+func validatePlacement(ctx context.Context, client *Client, accountID string, obj, prior types.Object) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if obj.IsNull() || obj.IsUnknown() || obj.Equal(prior) {
+		return diags
+	}
+
+	var placement PlacementModel
+	diags.Append(obj.As(ctx, &placement, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Values not known until apply are skipped.
+	var tagValues []types.String
+	if !placement.Tags.IsUnknown() {
+		diags.Append(placement.Tags.ElementsAs(ctx, &tagValues, false)...)
+	}
+	var tags []string
+	for _, t := range tagValues {
+		if !t.IsUnknown() {
+			tags = append(tags, t.ValueString())
+		}
+	}
+	if diags.HasError() || (placement.Cluster.IsUnknown() && len(tags) == 0) {
+		return diags
+	}
+
+	account, err := client.GetAccount(ctx, accountID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read account, got error: %s", err))
+		return diags
+	}
+
+	options, err := client.cachedPlacementOptions(ctx, account.SystemID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read placement options, got error: %s", err))
+		return diags
+	}
+
+	if cluster := placement.Cluster.ValueString(); cluster != "" && !slices.Contains(options.Clusters, cluster) {
+		diags.AddAttributeError(
+			path.Root("placement").AtName("cluster"),
+			"Invalid Placement Cluster",
+			fmt.Sprintf("Cluster %q is not available in system %q. Valid clusters are: %s.",
+				cluster, account.SystemID, strings.Join(options.Clusters, ", ")),
+		)
+	}
+
+	for _, tag := range tags {
+		if !slices.Contains(options.Tags, tag) {
+			diags.AddAttributeError(
+				path.Root("placement").AtName("tags"),
+				"Invalid Placement Tag",
+				fmt.Sprintf("Server tag %q is not available in system %q. Valid tags are: %s.",
+					tag, account.SystemID, strings.Join(options.Tags, ", ")),
+			)
+		}
+	}
+
+	return diags
+}
+
+// priorPlacement returns the placement attribute in state, or a null object
+// when the resource is being created or moved to another account, whose
+// system may offer different placements.
+func priorPlacement(ctx context.Context, state tfsdk.State, accountID string) (types.Object, diag.Diagnostics) {
+	prior := types.ObjectNull(placementAttrTypes)
+	if state.Raw.IsNull() {
+		return prior, nil
+	}
+
+	var priorAccountID types.String
+	diags := state.GetAttribute(ctx, path.Root("account_id"), &priorAccountID)
+	if diags.HasError() || priorAccountID.ValueString() != accountID {
+		return prior, diags
+	}
+
+	diags.Append(state.GetAttribute(ctx, path.Root("placement"), &prior)...)
+	return prior, diags
+}
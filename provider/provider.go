@@ -4,20 +4,32 @@
 package provider
 
 import (
+	"cmp"
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
+	"os"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure ScaffoldingProvider satisfies various provider interfaces.
 var _ provider.Provider = &ScaffoldingProvider{}
+var _ provider.ProviderWithListResources = &ScaffoldingProvider{}
 var _ provider.ProviderWithFunctions = &ScaffoldingProvider{}
 var _ provider.ProviderWithEphemeralResources = &ScaffoldingProvider{}
 
@@ -31,26 +43,148 @@ type ScaffoldingProvider struct {
 
 // ScaffoldingProviderModel describes the provider data model.
 type ScaffoldingProviderModel struct {
-	Endpoint types.String `tfsdk:"endpoint"`
+	Endpoint              types.String  `tfsdk:"endpoint"`
+	Token                 types.String  `tfsdk:"token"`
+	APIEndpoint           types.String  `tfsdk:"api_endpoint"`
+	APIToken              types.String  `tfsdk:"api_token"`
+	Profile               types.String  `tfsdk:"profile"`
+	MaxRetries            types.Int64   `tfsdk:"max_retries"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+	CACertFile            types.String  `tfsdk:"ca_cert_file"`
+	ClientCertFile        types.String  `tfsdk:"client_cert_file"`
+	ClientKeyFile         types.String  `tfsdk:"client_key_file"`
+	InsecureSkipVerify    types.Bool    `tfsdk:"insecure_skip_verify"`
+	ProxyURL              types.String  `tfsdk:"proxy_url"`
+	RequestTimeout        types.String  `tfsdk:"request_timeout"`
+	Headers               types.Map     `tfsdk:"headers"`
+	TracingExporter       types.String  `tfsdk:"tracing_exporter"`
+	TracingFile           types.String  `tfsdk:"tracing_file"`
 }
 
 func (p *ScaffoldingProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
-	resp.TypeName = "scaffolding"
+	resp.TypeName = "synadia"
 	resp.Version = p.version
 }
 
 func (p *ScaffoldingProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	// Descriptions are plain text: the muxed SDKv2 provider can only report
+	// plain text ones, and the mux requires both provider schemas to match.
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"endpoint": schema.StringAttribute{
-				MarkdownDescription: "The Synadia control plane endpoint.",
-				DefaultFunc: schema.EnvDefaultFunc("SYNADIA_API_ENDPOINT", "https://api.synadia.cloud"),
-				Optional:            true,
+				Description: "The Synadia control plane endpoint. May also be set with the `SYNADIA_API_ENDPOINT` " +
+					"environment variable or the `endpoint` key of the selected profile.",
+				Optional: true,
 			},
 			"token": schema.StringAttribute{
-				MarkdownDescription: "API token for authenticating to Synadia control plane.",
-				Required:	true,
-				Sensitive:	true,
+				Description: "API token for authenticating to Synadia control plane. May also be set with the " +
+					"`SYNADIA_API_TOKEN` environment variable or the `token` key of the selected profile.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"api_endpoint": schema.StringAttribute{
+				Description:        "Deprecated alias of `endpoint`.",
+				DeprecationMessage: "Use endpoint instead.",
+				Optional:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("endpoint")),
+				},
+			},
+			"api_token": schema.StringAttribute{
+				Description:        "Deprecated alias of `token`.",
+				DeprecationMessage: "Use token instead.",
+				Optional:           true,
+				Sensitive:          true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("token")),
+				},
+			},
+			"profile": schema.StringAttribute{
+				Description: "Profile to read from the credentials file (`SYNADIA_CREDENTIALS_FILE`, by default " +
+					"`synadia/credentials` in the user config directory such as `~/.config`). May also be set with the " +
+					"`SYNADIA_PROFILE` environment variable. Defaults to `default`.",
+				Optional: true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum number of times a request is retried after a rate limit, "+
+					"a 502/503/504 response or a connection error. Defaults to `%d`; `0` disables retries.", defaultMaxRetries),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum number of control plane requests in flight at once, shared by all "+
+					"resources and data sources of this provider instance. Defaults to `%d`.", defaultMaxConcurrentRequests),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Description: "Maximum sustained rate of control plane requests, shared by all resources and data " +
+					"sources of this provider instance. Unlimited when unset or `0`.",
+				Optional: true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "Path to a PEM bundle of CA certificates trusted in addition to the system roots, " +
+					"for control planes served with a private CA.",
+				Optional: true,
+			},
+			"client_cert_file": schema.StringAttribute{
+				Description: "Path to a PEM client certificate presented to the control plane (mTLS). " +
+					"Requires `client_key_file`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key_file")),
+				},
+			},
+			"client_key_file": schema.StringAttribute{
+				Description: "Path to the PEM private key for `client_cert_file`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert_file")),
+				},
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Skip verification of the control plane's TLS certificate. Only use this in labs; " +
+					"it makes the connection vulnerable to interception.",
+				Optional: true,
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "HTTP(S) proxy for control plane requests, e.g. `http://proxy.internal:3128`. " +
+					"Defaults to the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables.",
+				Optional: true,
+			},
+			"request_timeout": schema.StringAttribute{
+				Description: "Maximum duration of a single control plane call including its retries, " +
+					"e.g. `30s` or `2m`. Unlimited by default.",
+				Optional: true,
+			},
+			"headers": schema.MapAttribute{
+				Description: "Extra HTTP headers sent with every control plane request, e.g. for an " +
+					"authenticating gateway. Headers the provider sets itself are not overridden.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"tracing_exporter": schema.StringAttribute{
+				Description: "Export OpenTelemetry traces of resource operations and control plane calls: " +
+					"`otlp` sends them to the collector set by the `OTEL_EXPORTER_OTLP_*` environment variables " +
+					"(by default `localhost:4318`), `file` appends them to `tracing_file`. May also be set with the " +
+					"`SYNADIA_TRACING_EXPORTER` environment variable. Tracing is disabled by default.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(tracingExporterOTLP, tracingExporterFile),
+				},
+			},
+			"tracing_file": schema.StringAttribute{
+				Description: "File the `file` tracing exporter appends spans to as JSON. May also be set with the " +
+					"`SYNADIA_TRACING_FILE` environment variable. Defaults to `synadia-traces.json`.",
+				Optional: true,
 			},
 		},
 	}
@@ -65,40 +199,181 @@ func (p *ScaffoldingProvider) Configure(ctx context.Context, req provider.Config
 		return
 	}
 
-	// Configuration values are now available.
-	// if data.Endpoint.IsNull() { /* ... */ }
+	if data.Token.IsUnknown() || data.APIToken.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token"),
+			"Unknown Synadia API Token",
+			"The provider cannot create the Synadia API client as there is an unknown configuration value for the API token.",
+		)
+		return
+	}
+
+	creds, err := LoadCredentials(
+		cmp.Or(data.Endpoint.ValueString(), data.APIEndpoint.ValueString()),
+		cmp.Or(data.Token.ValueString(), data.APIToken.ValueString()),
+		data.Profile.ValueString(),
+	)
+	if errors.Is(err, ErrMissingToken) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token"),
+			"Missing Synadia API Token",
+			"The provider cannot create the Synadia API client as no API token is configured. Set the token attribute, "+
+				"the SYNADIA_API_TOKEN environment variable or a token in the credentials file profile.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("profile"),
+			"Unable to Load Synadia Profile",
+			fmt.Sprintf("The provider cannot read the credentials file: %s", err),
+		)
+		return
+	}
+
+	maxRetries := defaultMaxRetries
+	if !data.MaxRetries.IsNull() {
+		maxRetries = int(data.MaxRetries.ValueInt64())
+	}
+
+	maxConcurrent := defaultMaxConcurrentRequests
+	if !data.MaxConcurrentRequests.IsNull() {
+		maxConcurrent = int(data.MaxConcurrentRequests.ValueInt64())
+	}
 
-	// Example client configuration for data sources and resources
-	client := http.DefaultClient
+	var requestTimeout time.Duration
+	if !data.RequestTimeout.IsNull() {
+		requestTimeout, err = time.ParseDuration(data.RequestTimeout.ValueString())
+		if err != nil || requestTimeout < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid Request Timeout",
+				fmt.Sprintf("Expected a non-negative duration such as \"30s\", got %q.", data.RequestTimeout.ValueString()),
+			)
+			return
+		}
+	}
+
+	var headers map[string]string
+	resp.Diagnostics.Append(data.Headers.ElementsAs(ctx, &headers, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	base, err := newBaseTransport(httpOptions{
+		caCertFile:         data.CACertFile.ValueString(),
+		clientCertFile:     data.ClientCertFile.ValueString(),
+		clientKeyFile:      data.ClientKeyFile.ValueString(),
+		insecureSkipVerify: data.InsecureSkipVerify.ValueBool(),
+		proxyURL:           data.ProxyURL.ValueString(),
+		headers:            headers,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Configure HTTP Client",
+			fmt.Sprintf("The provider cannot create the Synadia API client: %s", err),
+		)
+		return
+	}
+
+	if httpLoggingEnabled() {
+		base = newLogTransport(base)
+	}
+
+	tracingExporter := cmp.Or(data.TracingExporter.ValueString(), os.Getenv(envTracingExporter))
+	tracingFile := cmp.Or(data.TracingFile.ValueString(), os.Getenv(envTracingFile), defaultTracingFile)
+
+	tr, err := newTracing(ctx, tracingExporter, tracingFile, p.version)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("tracing_exporter"),
+			"Unable to Configure Tracing",
+			fmt.Sprintf("The provider cannot set up OpenTelemetry tracing: %s", err),
+		)
+		return
+	}
+
+	// Retries sit above the limiter so every attempt waits for a slot and a
+	// token, while the backoff between attempts holds neither.
+	limited := newLimitTransport(base, maxConcurrent, data.RequestsPerSecond.ValueFloat64())
+	var transport http.RoundTripper = newRetryTransport(limited, maxRetries)
+	if tr.tracer != nil {
+		transport = newTraceTransport(transport, tr.tracer)
+	}
+	httpClient := &http.Client{
+		Transport: transport,
+		Timeout:   requestTimeout,
+	}
+
+	client := NewClient(creds.Endpoint, creds.Token, httpClient)
+	client.tracing = tr
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.ListResourceData = client
 }
 
 func (p *ScaffoldingProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
-		NewExampleResource,
+		NewAccountResource,
+		NewAppUserResource,
+		NewAppUserRoleBindingResource,
+		NewNatsUserResource,
+		NewStreamResource,
+		NewConsumerResource,
+		NewKVBucketResource,
+		NewObjectBucketResource,
+	}
+}
+
+func (p *ScaffoldingProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewAccountListResource,
+		NewNatsUserListResource,
+		NewStreamListResource,
+		NewConsumerListResource,
+		NewKVBucketListResource,
+		NewObjectBucketListResource,
 	}
 }
 
-/*
 func (p *ScaffoldingProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
 	return []func() ephemeral.EphemeralResource{
-		NewExampleEphemeralResource,
+		NewNKeyEphemeralResource,
 	}
 }
-*/
+
 func (p *ScaffoldingProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
-		NewExampleDataSource,
+		NewRolesDataSource,
+		NewJetStreamPlacementOptionsDataSource,
+		NewJetStreamAssetsDataSource,
+		NewStreamDataSource,
+		NewStreamsDataSource,
+		NewKVBucketDataSource,
+		NewKVBucketsDataSource,
+		NewObjectBucketsDataSource,
+		NewConsumersDataSource,
+		NewMirrorConsumersDataSource,
+		NewKVConsumersDataSource,
+		NewObjectConsumersDataSource,
+		NewAccountsDataSource,
+		NewTeamAccountsDataSource,
+		NewUsersDataSource,
+		NewTeamNatsUsersDataSource,
+		NewTeamsDataSource,
 	}
 }
-/*
+
 func (p *ScaffoldingProvider) Functions(ctx context.Context) []func() function.Function {
 	return []func() function.Function{
-		NewExampleFunction,
+		NewSubjectValidFunction,
+		NewSubjectMatchesFunction,
+		NewJWTDecodeFunction,
+		NewCredsFormatFunction,
+		NewCredsParseFunction,
 	}
 }
-*/
+
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &ScaffoldingProvider{
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Resources remember the revision (ETag) of the object they last read in
+// private state, together with the object itself. Update sends the revision
+// back as a precondition; when the control plane rejects it because someone
+// changed the object in the meantime, the stored object is compared with the
+// current one so the diagnostic can show what changed remotely.
+
+// remotePrivateKey is the private state key holding the observed revision.
+const remotePrivateKey = "remote"
+
+type observedRemote struct {
+	Revision string          `json:"revision"`
+	Object   json.RawMessage `json:"object"`
+}
+
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// saveRevision records revision and the object it belongs to. object should
+// only hold fields the resource manages, so that status changes such as
+// message counts do not show up as conflicts.
+func saveRevision(ctx context.Context, private privateStateSetter, revision string, object any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	raw, err := json.Marshal(object)
+	if err != nil {
+		diags.AddError("Internal Error", fmt.Sprintf("Unable to encode the observed object, got error: %s", err))
+		return diags
+	}
+
+	value, err := json.Marshal(observedRemote{Revision: revision, Object: raw})
+	if err != nil {
+		diags.AddError("Internal Error", fmt.Sprintf("Unable to encode the observed revision, got error: %s", err))
+		return diags
+	}
+
+	return private.SetKey(ctx, remotePrivateKey, value)
+}
+
+// loadRevision returns what saveRevision recorded. State written before
+// revisions were tracked yields an empty revision, which makes the next
+// update unconditional.
+func loadRevision(ctx context.Context, private privateStateGetter) (observedRemote, diag.Diagnostics) {
+	var observed observedRemote
+
+	value, diags := private.GetKey(ctx, remotePrivateKey)
+	if diags.HasError() || len(value) == 0 {
+		return observed, diags
+	}
+
+	if err := json.Unmarshal(value, &observed); err != nil {
+		diags.AddError("Internal Error", fmt.Sprintf("Unable to decode the observed revision, got error: %s", err))
+	}
+
+	return observed, diags
+}
+
+// addConflictError reports an update rejected because the object changed
+// after it was last read. current is the object as it is now, in the same
+// shape that was passed to saveRevision.
+func addConflictError(diags *diag.Diagnostics, what string, observed observedRemote, current any) {
+	var changes []string
+
+	if raw, err := json.Marshal(current); err == nil && len(observed.Object) > 0 {
+		before, after := flattenJSON(observed.Object), flattenJSON(raw)
+		keys := slices.Collect(maps.Keys(before))
+		for k := range after {
+			if _, ok := before[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		slices.Sort(keys)
+
+		for _, k := range keys {
+			b, a := before[k], after[k]
+			if b == a {
+				continue
+			}
+			if b == "" {
+				b = "(unset)"
+			}
+			if a == "" {
+				a = "(unset)"
+			}
+			changes = append(changes, fmt.Sprintf("  %s: %s -> %s", k, b, a))
+		}
+	}
+
+	detail := fmt.Sprintf("The %s was changed outside of Terraform after it was last read, so the update was "+
+		"not applied to avoid overwriting that change.", what)
+	if len(changes) > 0 {
+		detail += "\n\nRemote changes:\n" + strings.Join(changes, "\n")
+	}
+	detail += "\n\nRun `terraform plan` to refresh the state and review the differences, then apply again."
+
+	diags.AddError("Resource Changed Outside Terraform", detail)
+}
+
+// flattenJSON maps the dotted path of every leaf of a JSON object to its
+// encoded value. Arrays are treated as leaves.
+func flattenJSON(raw json.RawMessage) map[string]string {
+	out := map[string]string{}
+
+	var walk func(prefix string, v any)
+	walk = func(prefix string, v any) {
+		if m, ok := v.(map[string]any); ok && (len(m) > 0 || prefix == "") {
+			for k, child := range m {
+				if prefix != "" {
+					k = prefix + "." + k
+				}
+				walk(k, child)
+			}
+			return
+		}
+		b, _ := json.Marshal(v)
+		out[prefix] = string(b)
+	}
+
+	var v any
+	if err := json.Unmarshal(raw, &v); err == nil {
+		walk("", v)
+	}
+
+	return out
+}
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RolesDataSource{}
+
+func NewRolesDataSource() datasource.DataSource {
+	return &RolesDataSource{}
+}
+
+// RolesDataSource defines the data source implementation.
+type RolesDataSource struct {
+	client *Client
+}
+
+// RolesDataSourceModel describes the data source data model.
+type RolesDataSourceModel struct {
+	Scope types.String `tfsdk:"scope"`
+	Roles []RoleModel  `tfsdk:"roles"`
+}
+
+// RoleModel describes a single role entry.
+type RoleModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Scope       types.String `tfsdk:"scope"`
+}
+
+func (d *RolesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_roles"
+}
+
+func (d *RolesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the roles that can be bound to app users.",
+
+		Attributes: map[string]schema.Attribute{
+			"scope": schema.StringAttribute{
+				MarkdownDescription: "Only return roles for this scope: `org`, `team`, `system` or `account`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(roleScopeOrg, roleScopeTeam, roleScopeSystem, roleScopeAccount),
+				},
+			},
+			"roles": schema.ListNestedAttribute{
+				MarkdownDescription: "Available roles",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Role identifier",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Role name, as used by `synadia_app_user_role_binding`",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Role description",
+							Computed:            true,
+						},
+						"scope": schema.StringAttribute{
+							MarkdownDescription: "Scope the role applies to",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RolesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RolesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roles, err := d.client.ListRoles(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list roles, got error: %s", err))
+		return
+	}
+
+	data.Roles = []RoleModel{}
+	for _, r := range roles {
+		if !data.Scope.IsNull() && r.Scope != data.Scope.ValueString() {
+			continue
+		}
+		data.Roles = append(data.Roles, RoleModel{
+			Id:          types.StringValue(r.ID),
+			Name:        types.StringValue(r.Name),
+			Description: types.StringValue(r.Description),
+			Scope:       types.StringValue(r.Scope),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// checkSDKv2State reports an error if a synadia_stream, synadia_consumer or
+// synadia_kv_bucket state was written by the SDKv2 provider, which served
+// those types until they moved here. That state addressed assets by cluster
+// and control plane ID and has no account_id, so reading it would look up an
+// asset that does not exist and silently drop it from state. It returns
+// whether such state was found.
+func checkSDKv2State(diags *diag.Diagnostics, typeName string, accountID types.String) bool {
+	if !accountID.IsNull() {
+		return false
+	}
+	diags.AddError(
+		"Unsupported SDKv2 State",
+		fmt.Sprintf("This %s was created by an earlier version of the provider and its state cannot be read. "+
+			"Remove it from state without destroying it and import it again by account ID and name; see "+
+			"\"Migrating from the SDKv2 resources\" in the README.", typeName),
+	)
+	return true
+}
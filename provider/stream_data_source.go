@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &StreamDataSource{}
+
+func NewStreamDataSource() datasource.DataSource {
+	return &StreamDataSource{}
+}
+
+// StreamDataSource defines the data source implementation.
+type StreamDataSource struct {
+	client *Client
+}
+
+// StreamDataSourceModel describes the data source data model.
+type StreamDataSourceModel struct {
+	AccountId     types.String      `tfsdk:"account_id"`
+	Name          types.String      `tfsdk:"name"`
+	Id            types.String      `tfsdk:"id"`
+	Description   types.String      `tfsdk:"description"`
+	Subjects      []string          `tfsdk:"subjects"`
+	Retention     types.String      `tfsdk:"retention"`
+	Storage       types.String      `tfsdk:"storage"`
+	Replicas      types.Int64       `tfsdk:"replicas"`
+	MaxMsgs       types.Int64       `tfsdk:"max_msgs"`
+	MaxBytes      types.Int64       `tfsdk:"max_bytes"`
+	MaxAgeSeconds types.Int64       `tfsdk:"max_age_seconds"`
+	Placement     types.Object      `tfsdk:"placement"`
+	Tags          []string          `tfsdk:"tags"`
+	Leader        types.String      `tfsdk:"leader"`
+	State         *StreamStateModel `tfsdk:"state"`
+}
+
+func (d *StreamDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stream"
+}
+
+func (d *StreamDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the configuration and runtime state of a JetStream stream, e.g. one managed by " +
+			"another configuration.",
+
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "Account the stream belongs to",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Stream name",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Stream identifier in the form `<account_id>/<stream_name>`",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Stream description",
+				Computed:            true,
+			},
+			"subjects": schema.ListAttribute{
+				MarkdownDescription: "Subjects the stream captures",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"retention": schema.StringAttribute{
+				MarkdownDescription: "Retention policy",
+				Computed:            true,
+			},
+			"storage": schema.StringAttribute{
+				MarkdownDescription: "Storage backend",
+				Computed:            true,
+			},
+			"replicas": schema.Int64Attribute{
+				MarkdownDescription: "Number of replicas",
+				Computed:            true,
+			},
+			"max_msgs": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of messages, `-1` for unlimited",
+				Computed:            true,
+			},
+			"max_bytes": schema.Int64Attribute{
+				MarkdownDescription: "Maximum size of the stream in bytes, `-1` for unlimited",
+				Computed:            true,
+			},
+			"max_age_seconds": schema.Int64Attribute{
+				MarkdownDescription: "Maximum age of messages in seconds, `0` for unlimited",
+				Computed:            true,
+			},
+			"placement": placementDataSourceAttribute("stream"),
+			"tags": schema.ListAttribute{
+				MarkdownDescription: "Tags of the stream",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"leader": schema.StringAttribute{
+				MarkdownDescription: "Server currently leading the stream's RAFT group, if it is replicated",
+				Computed:            true,
+			},
+			"state": streamStateAttribute("stream"),
+		},
+	}
+}
+
+func (d *StreamDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *StreamDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data StreamDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountID := data.AccountId.ValueString()
+	name := data.Name.ValueString()
+
+	stream, err := d.client.GetStream(ctx, accountID, name)
+	if IsNotFound(err) {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Stream Not Found",
+			fmt.Sprintf("Account %q has no stream named %q.", accountID, name))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read stream, got error: %s", err))
+		return
+	}
+
+	cfg := stream.Config
+	data.Id = types.StringValue(compositeID(accountID, cfg.Name))
+	data.Description = types.StringValue(cfg.Description)
+	data.Subjects = append([]string{}, cfg.Subjects...)
+	data.Retention = types.StringValue(cfg.Retention)
+	data.Storage = types.StringValue(cfg.Storage)
+	data.Replicas = types.Int64Value(cfg.Replicas)
+	data.MaxMsgs = types.Int64Value(cfg.MaxMsgs)
+	data.MaxBytes = types.Int64Value(cfg.MaxBytes)
+	data.MaxAgeSeconds = types.Int64Value(cfg.MaxAge / NanosPerSecond)
+	data.Tags = append([]string{}, stream.Tags...)
+	data.Leader = types.StringNull()
+	if stream.Cluster != nil {
+		data.Leader = optionalString(stream.Cluster.Leader)
+	}
+	data.State = streamStateFromAPI(stream.State)
+
+	placement, diags := placementFromAPI(ctx, cfg.Placement)
+	resp.Diagnostics.Append(diags...)
+	data.Placement = placement
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
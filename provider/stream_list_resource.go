@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &StreamListResource{}
+var _ list.ListResourceWithConfigure = &StreamListResource{}
+
+func NewStreamListResource() list.ListResource {
+	return &StreamListResource{}
+}
+
+// StreamListResource defines the list resource implementation.
+type StreamListResource struct {
+	client *Client
+}
+
+// StreamListResourceModel describes the list resource config data model.
+type StreamListResourceModel struct {
+	AccountId  types.String `tfsdk:"account_id"`
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	Tag        types.String `tfsdk:"tag"`
+}
+
+func (r *StreamListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stream"
+}
+
+func (r *StreamListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the JetStream streams of an account. Streams backing KV and object buckets are " +
+			"listed by `synadia_kv_bucket` and `synadia_object_bucket` instead.",
+
+		Attributes: map[string]listschema.Attribute{
+			"account_id": listschema.StringAttribute{
+				MarkdownDescription: "Account to list streams in",
+				Required:            true,
+			},
+			"name_prefix": namePrefixAttribute("streams"),
+			"tag":         tagAttribute("streams"),
+		},
+	}
+}
+
+func (r *StreamListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *StreamListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config StreamListResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	accountID := config.AccountId.ValueString()
+
+	streams, err := r.client.ListStreams(ctx, accountID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list streams, got error: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	filter := newListFilter(config.NamePrefix, config.Tag)
+	matched := make([]Stream, 0, len(streams))
+	for _, s := range streams {
+		if !IsBucketStream(s.Config.Name) && filter.matches(s.Config.Name, s.Tags) {
+			matched = append(matched, s)
+		}
+	}
+
+	stream.Results = listResults(ctx, req, matched, func(s *Stream, result *list.ListResult) {
+		data := StreamResourceModel{Timeouts: nullStreamTimeouts()}
+		result.DisplayName = s.Config.Name
+		result.Diagnostics.Append(data.fromAPI(ctx, accountID, s)...)
+		result.Diagnostics.Append(result.Identity.Set(ctx, data.identity())...)
+		if req.IncludeResource {
+			result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+		}
+	})
+}
@@ -0,0 +1,645 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &StreamResource{}
+var _ resource.ResourceWithImportState = &StreamResource{}
+var _ resource.ResourceWithIdentity = &StreamResource{}
+var _ resource.ResourceWithValidateConfig = &StreamResource{}
+var _ resource.ResourceWithModifyPlan = &StreamResource{}
+
+func NewStreamResource() resource.Resource {
+	return &StreamResource{}
+}
+
+// StreamResource defines the resource implementation.
+type StreamResource struct {
+	client *Client
+}
+
+// StreamResourceModel describes the resource data model.
+type StreamResourceModel struct {
+	Id            types.String   `tfsdk:"id"`
+	AccountId     types.String   `tfsdk:"account_id"`
+	Name          types.String   `tfsdk:"name"`
+	Description   types.String   `tfsdk:"description"`
+	Subjects      types.List     `tfsdk:"subjects"`
+	Retention     types.String   `tfsdk:"retention"`
+	Storage       types.String   `tfsdk:"storage"`
+	Replicas      types.Int64    `tfsdk:"replicas"`
+	MaxMsgs       types.Int64    `tfsdk:"max_msgs"`
+	MaxBytes      types.Int64    `tfsdk:"max_bytes"`
+	MaxAgeSeconds types.Int64    `tfsdk:"max_age_seconds"`
+	Placement     types.Object   `tfsdk:"placement"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// streamAPIFields maps stream request fields to schema attributes.
+var streamAPIFields = apiFieldPaths{
+	"name":         path.Root("name"),
+	"description":  path.Root("description"),
+	"subjects[]":   path.Root("subjects"),
+	"retention":    path.Root("retention"),
+	"storage":      path.Root("storage"),
+	"num_replicas": path.Root("replicas"),
+	"max_msgs":     path.Root("max_msgs"),
+	"max_bytes":    path.Root("max_bytes"),
+	"max_age":      path.Root("max_age_seconds"),
+}.withPlacement()
+
+// StreamResourceIdentityModel describes the resource identity data model.
+type StreamResourceIdentityModel struct {
+	AccountId types.String `tfsdk:"account_id"`
+	Name      types.String `tfsdk:"name"`
+}
+
+func (r *StreamResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stream"
+}
+
+func (r *StreamResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a JetStream stream. Create and update wait until the stream has a leader and all replicas are current; " +
+			"delete waits until the stream is gone. Import with `<account_id>/<stream_name>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Stream identifier in the form `<account_id>/<stream_name>`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "Account the stream belongs to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Stream name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Stream description",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"subjects": schema.ListAttribute{
+				MarkdownDescription: "Subjects the stream captures",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+			"retention": schema.StringAttribute{
+				MarkdownDescription: "Retention policy: `limits`, `interest` or `workqueue`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("limits"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("limits", "interest", "workqueue"),
+				},
+			},
+			"storage": schema.StringAttribute{
+				MarkdownDescription: "Storage backend: `file` or `memory`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("file"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("file", "memory"),
+				},
+			},
+			"replicas": schema.Int64Attribute{
+				MarkdownDescription: "Number of replicas",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+			},
+			"max_msgs": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of messages, `-1` for unlimited",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"max_bytes": schema.Int64Attribute{
+				MarkdownDescription: "Maximum size of the stream in bytes, `-1` for unlimited",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"max_age_seconds": schema.Int64Attribute{
+				MarkdownDescription: "Maximum age of messages in seconds, `0` for unlimited",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+			},
+			"placement": placementAttribute("stream"),
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *StreamResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"account_id": identityschema.StringAttribute{
+				Description:       "Account the stream belongs to",
+				RequiredForImport: true,
+			},
+			"name": identityschema.StringAttribute{
+				Description:       "Stream name",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that every subject is well-formed and that the
+// stream's own subjects do not overlap, both of which JetStream rejects.
+func (r *StreamResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var subjects []types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("subjects"), &subjects)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, s := range subjects {
+		if s.IsNull() || s.IsUnknown() {
+			continue
+		}
+		if err := validateSubject(s.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("subjects").AtListIndex(i),
+				"Invalid Subject",
+				fmt.Sprintf("Subject %q is not valid: %s.", s.ValueString(), err),
+			)
+			continue
+		}
+		for j := range i {
+			other := subjects[j]
+			if other.IsNull() || other.IsUnknown() || validateSubject(other.ValueString()) != nil {
+				continue
+			}
+			if subjectsOverlap(s.ValueString(), other.ValueString()) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("subjects").AtListIndex(i),
+					"Overlapping Stream Subjects",
+					fmt.Sprintf("Subject %q overlaps subject %q of the same stream.", s.ValueString(), other.ValueString()),
+				)
+				break
+			}
+		}
+	}
+}
+
+// ModifyPlan rejects placements the account's system cannot satisfy and
+// subjects that overlap another stream planned through this provider
+// instance, so both surface during plan rather than apply. Overlaps with an
+// existing stream that is not planned (yet) are only warned about, as the
+// order streams are planned in is arbitrary and that stream may still
+// release the subject.
+func (r *StreamResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		var state StreamResourceModel
+
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+		if !resp.Diagnostics.HasError() {
+			r.client.streamSubjects.register(state.AccountId.ValueString(), state.Name.ValueString(), nil)
+		}
+		return
+	}
+
+	var plan StreamResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() || plan.AccountId.IsUnknown() {
+		return
+	}
+
+	accountID := plan.AccountId.ValueString()
+
+	prior, diags := priorPlacement(ctx, req.State, accountID)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validatePlacement(ctx, r.client, accountID, plan.Placement, prior)...)
+
+	if resp.Diagnostics.HasError() || plan.Name.IsUnknown() || plan.Subjects.IsUnknown() {
+		return
+	}
+
+	var subjectValues []types.String
+	resp.Diagnostics.Append(plan.Subjects.ElementsAs(ctx, &subjectValues, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subjects := make([]string, 0, len(subjectValues))
+	for _, s := range subjectValues {
+		// Subjects not known until apply cannot be checked, and invalid ones
+		// are reported by ValidateConfig.
+		if s.IsUnknown() || validateSubject(s.ValueString()) != nil {
+			return
+		}
+		subjects = append(subjects, s.ValueString())
+	}
+
+	name := plan.Name.ValueString()
+
+	// A stream being renamed replaces its previous incarnation, whose
+	// subjects will be released.
+	var previous string
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("name"), &previous)...)
+	}
+
+	if conflict := r.client.streamSubjects.register(accountID, name, subjects); conflict != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("subjects"),
+			"Overlapping Stream Subjects",
+			fmt.Sprintf("Stream %q in account %q cannot be planned: %s. JetStream does not allow two streams in "+
+				"one account to capture the same subject.", name, accountID, conflict),
+		)
+		return
+	}
+
+	streams, err := r.client.ListStreams(ctx, accountID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list streams, got error: %s", err))
+		return
+	}
+
+	if conflict := r.client.streamSubjects.checkExisting(accountID, name, previous, subjects, streams); conflict != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("subjects"),
+			"Overlapping Stream Subjects",
+			fmt.Sprintf("Stream %q in account %q: %s. Unless that stream is changed or destroyed by this plan and "+
+				"releases the subject first, applying will fail, as JetStream does not allow two streams in one "+
+				"account to capture the same subject.", name, accountID, conflict),
+		)
+	}
+}
+
+func (r *StreamResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *StreamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := r.client.startOperation(ctx, "synadia_stream", "create")
+	defer func() { span.end(resp.Diagnostics) }()
+
+	var data StreamResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	span.setAccount(data.AccountId.ValueString())
+
+	cfg, diags := data.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stream, err := r.client.CreateStream(ctx, data.AccountId.ValueString(), cfg)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "create stream", err, streamAPIFields)
+		return
+	}
+
+	tflog.Trace(ctx, "created a stream", map[string]any{"account_id": data.AccountId.ValueString(), "name": stream.Config.Name})
+
+	// Save the stream before waiting so a timeout leaves it tracked (and
+	// tainted) rather than orphaned.
+	resp.Diagnostics.Append(data.fromAPI(ctx, data.AccountId.ValueString(), stream)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stream, err = r.waitReady(ctx, data.AccountId.ValueString(), cfg.Name, createTimeout)
+	if err != nil {
+		addWaitError(&resp.Diagnostics, "stream to become ready", err)
+		return
+	}
+
+	resp.Diagnostics.Append(data.fromAPI(ctx, data.AccountId.ValueString(), stream)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(saveRevision(ctx, resp.Private, stream.Revision, stream.Config)...)
+}
+
+func (r *StreamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := r.client.startOperation(ctx, "synadia_stream", "read")
+	defer func() { span.end(resp.Diagnostics) }()
+
+	var data StreamResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if checkSDKv2State(&resp.Diagnostics, "synadia_stream", data.AccountId) {
+		return
+	}
+
+	span.setAccount(data.AccountId.ValueString())
+
+	stream, err := r.client.GetStream(ctx, data.AccountId.ValueString(), data.Name.ValueString())
+	if IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read stream, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.fromAPI(ctx, data.AccountId.ValueString(), stream)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+	resp.Diagnostics.Append(saveRevision(ctx, resp.Private, stream.Revision, stream.Config)...)
+}
+
+func (r *StreamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := r.client.startOperation(ctx, "synadia_stream", "update")
+	defer func() { span.end(resp.Diagnostics) }()
+
+	var data StreamResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	span.setAccount(data.AccountId.ValueString())
+
+	cfg, diags := data.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	observed, diags := loadRevision(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.UpdateStream(ctx, data.AccountId.ValueString(), cfg, observed.Revision)
+	if IsConflict(err) {
+		current, getErr := r.client.GetStream(ctx, data.AccountId.ValueString(), cfg.Name)
+		if getErr != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read changed stream, got error: %s", getErr))
+			return
+		}
+		addConflictError(&resp.Diagnostics, "stream", observed, current.Config)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "update stream", err, streamAPIFields)
+		return
+	}
+
+	// Changing the replica count moves data between servers; wait for the
+	// new group to catch up before reporting success.
+	stream, err := r.waitReady(ctx, data.AccountId.ValueString(), cfg.Name, updateTimeout)
+	if err != nil {
+		addWaitError(&resp.Diagnostics, "stream to become ready", err)
+		return
+	}
+
+	resp.Diagnostics.Append(data.fromAPI(ctx, data.AccountId.ValueString(), stream)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+	resp.Diagnostics.Append(saveRevision(ctx, resp.Private, stream.Revision, stream.Config)...)
+}
+
+func (r *StreamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := r.client.startOperation(ctx, "synadia_stream", "delete")
+	defer func() { span.end(resp.Diagnostics) }()
+
+	var data StreamResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	span.setAccount(data.AccountId.ValueString())
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteStream(ctx, data.AccountId.ValueString(), data.Name.ValueString())
+	if IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete stream, got error: %s", err))
+		return
+	}
+
+	err = waitFor(ctx, deleteTimeout, "stream "+data.Name.ValueString()+" to be deleted", func(ctx context.Context) (bool, string, error) {
+		_, err := r.client.GetStream(ctx, data.AccountId.ValueString(), data.Name.ValueString())
+		if IsNotFound(err) {
+			return true, "deleted", nil
+		}
+		return false, "deleting", err
+	})
+	if err != nil {
+		addWaitError(&resp.Diagnostics, "stream deletion", err)
+		return
+	}
+}
+
+// nullStreamTimeouts returns an unset timeouts block for models built purely
+// from the API, such as list results, which never pass through a plan.
+func nullStreamTimeouts() timeouts.Value {
+	return timeouts.Value{
+		Object: types.ObjectNull(map[string]attr.Type{
+			"create": types.StringType,
+			"update": types.StringType,
+			"delete": types.StringType,
+		}),
+	}
+}
+
+// waitReady polls the stream until it has a leader and all replicas are
+// current, returning the last observed state.
+func (r *StreamResource) waitReady(ctx context.Context, accountID, name string, timeout time.Duration) (*Stream, error) {
+	var stream *Stream
+	err := waitFor(ctx, timeout, "stream "+name+" to become ready", func(ctx context.Context) (bool, string, error) {
+		s, err := r.client.GetStream(ctx, accountID, name)
+		if err != nil {
+			return false, "", err
+		}
+		stream = s
+		done, status := s.ready()
+		return done, status, nil
+	})
+	return stream, err
+}
+
+// ImportState accepts either "<account_id>/<stream_name>" or an import block identity.
+func (r *StreamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := req.ID
+	if id == "" {
+		var identity StreamResourceIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		id = compositeID(identity.AccountId.ValueString(), identity.Name.ValueString())
+	}
+
+	parts, err := parseCompositeID(id, 2, "account_id/stream_name")
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
+}
+
+func (m *StreamResourceModel) toAPI(ctx context.Context) (*StreamConfig, diag.Diagnostics) {
+	cfg := &StreamConfig{
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+		Retention:   m.Retention.ValueString(),
+		Storage:     m.Storage.ValueString(),
+		Replicas:    m.Replicas.ValueInt64(),
+		MaxMsgs:     m.MaxMsgs.ValueInt64(),
+		MaxBytes:    m.MaxBytes.ValueInt64(),
+		MaxAge:      m.MaxAgeSeconds.ValueInt64() * NanosPerSecond,
+	}
+	diags := m.Subjects.ElementsAs(ctx, &cfg.Subjects, false)
+
+	placement, placementDiags := placementToAPI(ctx, m.Placement)
+	diags.Append(placementDiags...)
+	cfg.Placement = placement
+	return cfg, diags
+}
+
+func (m *StreamResourceModel) fromAPI(ctx context.Context, accountID string, stream *Stream) diag.Diagnostics {
+	cfg := stream.Config
+	subjects, diags := types.ListValueFrom(ctx, types.StringType, cfg.Subjects)
+
+	m.Id = types.StringValue(compositeID(accountID, cfg.Name))
+	m.AccountId = types.StringValue(accountID)
+	m.Name = types.StringValue(cfg.Name)
+	m.Description = types.StringValue(cfg.Description)
+	m.Subjects = subjects
+	m.Retention = types.StringValue(cfg.Retention)
+	m.Storage = types.StringValue(cfg.Storage)
+	m.Replicas = types.Int64Value(cfg.Replicas)
+	m.MaxMsgs = types.Int64Value(cfg.MaxMsgs)
+	m.MaxBytes = types.Int64Value(cfg.MaxBytes)
+	m.MaxAgeSeconds = types.Int64Value(cfg.MaxAge / NanosPerSecond)
+
+	placement, placementDiags := placementFromAPI(ctx, cfg.Placement)
+	diags.Append(placementDiags...)
+	m.Placement = placement
+	return diags
+}
+
+func (m *StreamResourceModel) identity() *StreamResourceIdentityModel {
+	return &StreamResourceIdentityModel{
+		AccountId: m.AccountId,
+		Name:      m.Name,
+	}
+}
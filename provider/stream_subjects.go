@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+)
+
+// streamSubjectConflict describes a subject of one stream that overlaps a
+// subject of another stream in the same account.
+type streamSubjectConflict struct {
+	Subject      string
+	Stream       string
+	OtherSubject string
+	Planned      bool
+}
+
+func (c *streamSubjectConflict) Error() string {
+	kind := "existing"
+	if c.Planned {
+		kind = "planned"
+	}
+	return fmt.Sprintf("subject %q overlaps subject %q of %s stream %q", c.Subject, c.OtherSubject, kind, c.Stream)
+}
+
+// streamSubjectRegistry remembers the subjects of every stream planned by a
+// provider instance, so two new streams in one configuration that overlap are
+// caught at plan time even though neither exists yet. Streams planned for
+// destruction are registered without subjects, as they release theirs.
+type streamSubjectRegistry struct {
+	mu      sync.Mutex
+	streams map[string]map[string][]string // account ID -> stream name -> subjects
+}
+
+func newStreamSubjectRegistry() *streamSubjectRegistry {
+	return &streamSubjectRegistry{streams: map[string]map[string][]string{}}
+}
+
+// register records the planned subjects of a stream and returns the first
+// overlap with another stream registered for the same account, if any.
+// Registering a stream again replaces its subjects.
+func (r *streamSubjectRegistry) register(accountID, name string, subjects []string) *streamSubjectConflict {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	account := r.streams[accountID]
+	if account == nil {
+		account = map[string][]string{}
+		r.streams[accountID] = account
+	}
+	account[name] = subjects
+
+	for _, other := range slices.Sorted(maps.Keys(account)) {
+		if other == name {
+			continue
+		}
+		if c := findSubjectOverlap(subjects, other, account[other]); c != nil {
+			c.Planned = true
+			return c
+		}
+	}
+	return nil
+}
+
+// checkExisting returns the first overlap between subjects and an existing
+// stream of the account other than name or previous, the stream's name before
+// a rename. Streams that are also planned in the account are compared by
+// register against their planned subjects, which replace their existing ones,
+// so they are skipped here. An existing stream may still be planned later in
+// the same run and release the subject, so callers only warn about the
+// overlap; if the stream keeps the subject, its own register call fails.
+func (r *streamSubjectRegistry) checkExisting(accountID, name, previous string, subjects []string, streams []Stream) *streamSubjectConflict {
+	r.mu.Lock()
+	planned := maps.Clone(r.streams[accountID])
+	r.mu.Unlock()
+
+	for _, s := range streams {
+		if s.Config.Name == name || s.Config.Name == previous {
+			continue
+		}
+		if _, ok := planned[s.Config.Name]; ok {
+			continue
+		}
+		if c := findSubjectOverlap(subjects, s.Config.Name, s.Config.Subjects); c != nil {
+			return c
+		}
+	}
+	return nil
+}
+
+// findSubjectOverlap returns the first subject in subjects that overlaps one
+// of otherSubjects of stream other.
+func findSubjectOverlap(subjects []string, other string, otherSubjects []string) *streamSubjectConflict {
+	for _, s := range subjects {
+		for _, o := range otherSubjects {
+			if subjectsOverlap(s, o) {
+				return &streamSubjectConflict{Subject: s, Stream: other, OtherSubject: o}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+)
+
+func testStream(name string, subjects ...string) Stream {
+	return Stream{Config: StreamConfig{Name: name, Subjects: subjects}}
+}
+
+func TestStreamSubjectRegistry_Register(t *testing.T) {
+	r := newStreamSubjectRegistry()
+
+	if c := r.register("acc", "orders", []string{"orders.>"}); c != nil {
+		t.Fatalf("unexpected conflict: %s", c)
+	}
+	if c := r.register("other", "payments", []string{"orders.eu"}); c != nil {
+		t.Fatalf("unexpected conflict across accounts: %s", c)
+	}
+
+	c := r.register("acc", "eu", []string{"eu.>", "orders.eu"})
+	if c == nil {
+		t.Fatal("expected a conflict")
+	}
+	want := streamSubjectConflict{Subject: "orders.eu", Stream: "orders", OtherSubject: "orders.>", Planned: true}
+	if *c != want {
+		t.Errorf("got %+v, want %+v", *c, want)
+	}
+
+	// Registering a stream again replaces its subjects.
+	if c := r.register("acc", "eu", []string{"eu.>"}); c != nil {
+		t.Fatalf("unexpected conflict after replanning: %s", c)
+	}
+}
+
+func TestStreamSubjectRegistry_CheckExisting(t *testing.T) {
+	existing := []Stream{
+		testStream("orders", "orders.>"),
+		testStream("old-eu", "eu.>"),
+		testStream("payments", "payments.*"),
+	}
+
+	tests := map[string]struct {
+		planned  map[string]map[string][]string // account ID -> stream name -> subjects
+		name     string
+		previous string
+		subjects []string
+		want     *streamSubjectConflict
+	}{
+		"no overlap": {
+			name:     "audit",
+			subjects: []string{"audit.>"},
+		},
+		"overlaps existing stream": {
+			name:     "audit",
+			subjects: []string{"audit.>", "payments.eu"},
+			want:     &streamSubjectConflict{Subject: "payments.eu", Stream: "payments", OtherSubject: "payments.*"},
+		},
+		"own existing subjects": {
+			name:     "orders",
+			subjects: []string{"orders.eu"},
+		},
+		"subjects of the stream before a rename": {
+			name:     "eu",
+			previous: "old-eu",
+			subjects: []string{"eu.>"},
+		},
+		"existing stream planned with other subjects": {
+			planned:  map[string]map[string][]string{"acc": {"payments": {"billing.*"}}},
+			name:     "audit",
+			subjects: []string{"payments.eu"},
+		},
+		"existing stream planned in another account": {
+			planned:  map[string]map[string][]string{"other": {"payments": {"billing.*"}}},
+			name:     "audit",
+			subjects: []string{"payments.eu"},
+			want:     &streamSubjectConflict{Subject: "payments.eu", Stream: "payments", OtherSubject: "payments.*"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := newStreamSubjectRegistry()
+			for account, streams := range tt.planned {
+				for stream, subjects := range streams {
+					r.register(account, stream, subjects)
+				}
+			}
+
+			got := r.checkExisting("acc", tt.name, tt.previous, tt.subjects, existing)
+			switch {
+			case tt.want == nil && got != nil:
+				t.Fatalf("unexpected conflict: %s", got)
+			case tt.want != nil && got == nil:
+				t.Fatalf("expected conflict %+v", *tt.want)
+			case tt.want != nil && *got != *tt.want:
+				t.Errorf("got %+v, want %+v", *got, *tt.want)
+			}
+		})
+	}
+}
+
+// TestStreamSubjectRegistry_PlanOrder moves orders.> from the existing stream
+// orders to the new stream eu, planning the two streams in either order, and
+// checks the result does not depend on it: an overlap with an existing stream
+// not planned yet is reported by checkExisting, which callers only warn about,
+// and is turned into an error by register only if that stream keeps the
+// subject.
+func TestStreamSubjectRegistry_PlanOrder(t *testing.T) {
+	existing := []Stream{testStream("orders", "orders.>")}
+
+	// planOrders plans the orders stream with the given subjects, nil
+	// standing for its destruction.
+	planOrders := func(r *streamSubjectRegistry, subjects []string) *streamSubjectConflict {
+		return r.register("acc", "orders", subjects)
+	}
+	// planEU plans the new eu stream and returns the error and the warning
+	// it would report.
+	planEU := func(r *streamSubjectRegistry) (*streamSubjectConflict, *streamSubjectConflict) {
+		subjects := []string{"orders.>"}
+		if c := r.register("acc", "eu", subjects); c != nil {
+			return c, nil
+		}
+		return nil, r.checkExisting("acc", "eu", "", subjects, existing)
+	}
+
+	tests := map[string]struct {
+		orders    []string // planned subjects of orders, nil if destroyed
+		euFirst   bool
+		wantError bool
+		wantWarn  bool
+	}{
+		"subject moved, orders planned first": {
+			orders: []string{"archive.>"},
+		},
+		"subject moved, eu planned first": {
+			orders:   []string{"archive.>"},
+			euFirst:  true,
+			wantWarn: true,
+		},
+		"orders destroyed first": {},
+		"orders destroyed after eu is planned": {
+			euFirst:  true,
+			wantWarn: true,
+		},
+		"subject kept, orders planned first": {
+			orders:    []string{"orders.>"},
+			wantError: true,
+		},
+		"subject kept, eu planned first": {
+			orders:    []string{"orders.>"},
+			euFirst:   true,
+			wantError: true,
+			wantWarn:  true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := newStreamSubjectRegistry()
+
+			var errs, warn *streamSubjectConflict
+			if tt.euFirst {
+				errs, warn = planEU(r)
+				if c := planOrders(r, tt.orders); c != nil {
+					errs = c
+				}
+			} else {
+				if c := planOrders(r, tt.orders); c != nil {
+					errs = c
+				}
+				if c, w := planEU(r); c != nil {
+					errs = c
+				} else {
+					warn = w
+				}
+			}
+
+			if got := errs != nil; got != tt.wantError {
+				t.Errorf("got error %v, want error %t", errs, tt.wantError)
+			}
+			if got := warn != nil; got != tt.wantWarn {
+				t.Errorf("got warning %v, want warning %t", warn, tt.wantWarn)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &StreamsDataSource{}
+
+func NewStreamsDataSource() datasource.DataSource {
+	return &StreamsDataSource{}
+}
+
+// StreamsDataSource defines the data source implementation.
+type StreamsDataSource struct {
+	client *Client
+}
+
+// StreamsDataSourceModel describes the data source data model.
+type StreamsDataSourceModel struct {
+	AccountId types.String         `tfsdk:"account_id"`
+	NameRegex types.String         `tfsdk:"name_regex"`
+	Subject   types.String         `tfsdk:"subject"`
+	Storage   types.String         `tfsdk:"storage"`
+	Tags      types.Set            `tfsdk:"tags"`
+	Streams   []StreamSummaryModel `tfsdk:"streams"`
+}
+
+// StreamSummaryModel describes a single stream entry.
+type StreamSummaryModel struct {
+	Id            types.String      `tfsdk:"id"`
+	Name          types.String      `tfsdk:"name"`
+	Description   types.String      `tfsdk:"description"`
+	Subjects      []string          `tfsdk:"subjects"`
+	Retention     types.String      `tfsdk:"retention"`
+	Storage       types.String      `tfsdk:"storage"`
+	Replicas      types.Int64       `tfsdk:"replicas"`
+	MaxMsgs       types.Int64       `tfsdk:"max_msgs"`
+	MaxBytes      types.Int64       `tfsdk:"max_bytes"`
+	MaxAgeSeconds types.Int64       `tfsdk:"max_age_seconds"`
+	Tags          []string          `tfsdk:"tags"`
+	State         *StreamStateModel `tfsdk:"state"`
+}
+
+func streamSummaryFromAPI(accountID string, s *Stream) StreamSummaryModel {
+	cfg := s.Config
+	return StreamSummaryModel{
+		Id:            types.StringValue(compositeID(accountID, cfg.Name)),
+		Name:          types.StringValue(cfg.Name),
+		Description:   types.StringValue(cfg.Description),
+		Subjects:      append([]string{}, cfg.Subjects...),
+		Retention:     types.StringValue(cfg.Retention),
+		Storage:       types.StringValue(cfg.Storage),
+		Replicas:      types.Int64Value(cfg.Replicas),
+		MaxMsgs:       types.Int64Value(cfg.MaxMsgs),
+		MaxBytes:      types.Int64Value(cfg.MaxBytes),
+		MaxAgeSeconds: types.Int64Value(cfg.MaxAge / NanosPerSecond),
+		Tags:          append([]string{}, s.Tags...),
+		State:         streamStateFromAPI(s.State),
+	}
+}
+
+func (d *StreamsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_streams"
+}
+
+func (d *StreamsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := map[string]schema.Attribute{
+		"account_id": schema.StringAttribute{
+			MarkdownDescription: "Account to list streams in",
+			Required:            true,
+		},
+		"streams": schema.ListNestedAttribute{
+			MarkdownDescription: "Matching streams, ordered as returned by the control plane",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						MarkdownDescription: "Stream identifier in the form `<account_id>/<stream_name>`",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Stream name",
+						Computed:            true,
+					},
+					"description": schema.StringAttribute{
+						MarkdownDescription: "Stream description",
+						Computed:            true,
+					},
+					"subjects": schema.ListAttribute{
+						MarkdownDescription: "Subjects the stream captures",
+						ElementType:         types.StringType,
+						Computed:            true,
+					},
+					"retention": schema.StringAttribute{
+						MarkdownDescription: "Retention policy",
+						Computed:            true,
+					},
+					"storage": schema.StringAttribute{
+						MarkdownDescription: "Storage backend",
+						Computed:            true,
+					},
+					"replicas": schema.Int64Attribute{
+						MarkdownDescription: "Number of replicas",
+						Computed:            true,
+					},
+					"max_msgs": schema.Int64Attribute{
+						MarkdownDescription: "Maximum number of messages, `-1` for unlimited",
+						Computed:            true,
+					},
+					"max_bytes": schema.Int64Attribute{
+						MarkdownDescription: "Maximum size of the stream in bytes, `-1` for unlimited",
+						Computed:            true,
+					},
+					"max_age_seconds": schema.Int64Attribute{
+						MarkdownDescription: "Maximum age of messages in seconds, `0` for unlimited",
+						Computed:            true,
+					},
+					"tags": schema.ListAttribute{
+						MarkdownDescription: "Tags of the stream",
+						ElementType:         types.StringType,
+						Computed:            true,
+					},
+					"state": streamStateAttribute("stream"),
+				},
+			},
+		},
+	}
+	maps.Copy(attrs, assetFilterAttributes("streams", true))
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the JetStream streams of an account together with their runtime state. Streams " +
+			"backing KV and object buckets are listed by `synadia_kv_buckets` and `synadia_object_buckets` instead.",
+
+		Attributes: attrs,
+	}
+}
+
+func (d *StreamsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *StreamsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data StreamsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := newAssetFilter(ctx, data.NameRegex, data.Subject, data.Storage, data.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountID := data.AccountId.ValueString()
+
+	streams, err := d.client.ListStreams(ctx, accountID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list streams, got error: %s", err))
+		return
+	}
+
+	data.Streams = []StreamSummaryModel{}
+	for i := range streams {
+		s := &streams[i]
+		if IsBucketStream(s.Config.Name) || !filter.matches(s.Config.Name, s.Config.Subjects, s.Config.Storage, s.Tags) {
+			continue
+		}
+		data.Streams = append(data.Streams, streamSummaryFromAPI(accountID, s))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var (
+	_ function.Function = SubjectMatchesFunction{}
+)
+
+func NewSubjectMatchesFunction() function.Function {
+	return SubjectMatchesFunction{}
+}
+
+type SubjectMatchesFunction struct{}
+
+func (r SubjectMatchesFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "subject_matches"
+}
+
+func (r SubjectMatchesFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Check whether a NATS subject is matched by a wildcard pattern",
+		MarkdownDescription: "Returns `true` if every message published to `subject` would be received by a " +
+			"subscription on `pattern`. `subject` may itself contain wildcards, in which case it must be fully " +
+			"covered by `pattern`: `subject_matches(\"orders.>\", \"orders.*.new\")` is `true` but " +
+			"`subject_matches(\"orders.*\", \"orders.>\")` is `false`. Fails if either argument is not a valid subject.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "pattern",
+				MarkdownDescription: "Subject pattern, e.g. a stream subject or an export",
+			},
+			function.StringParameter{
+				Name:                "subject",
+				MarkdownDescription: "Subject to test, e.g. a consumer filter subject",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (r SubjectMatchesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var pattern, subject string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &pattern, &subject))
+
+	if resp.Error != nil {
+		return
+	}
+
+	if err := validateSubject(pattern); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid pattern %q: %s", pattern, err))
+		return
+	}
+	if err := validateSubject(subject); err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid subject %q: %s", subject, err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, subjectMatches(pattern, subject)))
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var (
+	_ function.Function = SubjectValidFunction{}
+)
+
+func NewSubjectValidFunction() function.Function {
+	return SubjectValidFunction{}
+}
+
+type SubjectValidFunction struct{}
+
+func (r SubjectValidFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "subject_valid"
+}
+
+func (r SubjectValidFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Check that a NATS subject is well-formed",
+		MarkdownDescription: "Returns `true` if `subject` is a valid NATS subject: dot separated, non-empty tokens " +
+			"without whitespace, where `*` matches a single token and `>` (only as the last token) matches one or " +
+			"more. Useful in `precondition` and variable `validation` blocks.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "subject",
+				MarkdownDescription: "Subject to check, may contain wildcards",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (r SubjectValidFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var subject string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &subject))
+
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, validateSubject(subject) == nil))
+}
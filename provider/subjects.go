@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"strings"
+)
+
+// NATS subject wildcards. Both only act as wildcards when they make up a whole
+// token; "foo*" is a literal token.
+const (
+	singleWildcard = "*"
+	fullWildcard   = ">"
+)
+
+// validateSubject reports why subject is not a well-formed NATS subject, or nil
+// if it is. Tokens are separated by '.', must be non-empty and must not
+// contain whitespace, and '>' may only be the last token.
+func validateSubject(subject string) error {
+	if subject == "" {
+		return errors.New("subject must not be empty")
+	}
+	if strings.ContainsAny(subject, " \t\r\n") {
+		return errors.New("subject must not contain whitespace")
+	}
+
+	tokens := strings.Split(subject, ".")
+	for i, token := range tokens {
+		if token == "" {
+			return errors.New("subject must not contain empty tokens")
+		}
+		if token == fullWildcard && i != len(tokens)-1 {
+			return errors.New("'>' wildcard must be the last token")
+		}
+	}
+	return nil
+}
+
+// subjectMatches reports whether every subject matched by subject is also
+// matched by pattern. For a literal subject this is ordinary NATS matching;
+// for a wildcard subject it means subject is a subset of pattern, e.g.
+// "orders.*" matches "orders.eu" and "orders.>" matches "orders.*.new", but
+// "orders.*" does not match "orders.>".
+//
+// Both arguments must be valid subjects.
+func subjectMatches(pattern, subject string) bool {
+	p := strings.Split(pattern, ".")
+	s := strings.Split(subject, ".")
+
+	for i, token := range p {
+		if token == fullWildcard {
+			return len(s) > i
+		}
+		if i >= len(s) || s[i] == fullWildcard {
+			return false
+		}
+		if token == singleWildcard {
+			continue
+		}
+		if s[i] == singleWildcard || s[i] != token {
+			return false
+		}
+	}
+	return len(p) == len(s)
+}
+
+// subjectsOverlap reports whether at least one subject is matched by both a
+// and b, e.g. "orders.*" and "*.eu". Both arguments must be valid subjects.
+func subjectsOverlap(a, b string) bool {
+	at := strings.Split(a, ".")
+	bt := strings.Split(b, ".")
+
+	for i := 0; i < len(at) && i < len(bt); i++ {
+		if at[i] == fullWildcard || bt[i] == fullWildcard {
+			return true
+		}
+		if at[i] != singleWildcard && bt[i] != singleWildcard && at[i] != bt[i] {
+			return false
+		}
+	}
+	return len(at) == len(bt)
+}
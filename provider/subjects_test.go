@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestValidateSubject(t *testing.T) {
+	tests := map[string]struct {
+		subject string
+		wantErr string
+	}{
+		"literal":                  {subject: "orders.eu.new"},
+		"single token":             {subject: "orders"},
+		"single wildcard":          {subject: "orders.*.new"},
+		"full wildcard":            {subject: "orders.>"},
+		"only full wildcard":       {subject: ">"},
+		"only single wildcard":     {subject: "*"},
+		"wildcard inside a token":  {subject: "orders.eu*"},
+		"full wildcard in a token": {subject: "orders.eu>.new"},
+		"empty": {
+			subject: "",
+			wantErr: "subject must not be empty",
+		},
+		"space": {
+			subject: "orders. eu",
+			wantErr: "subject must not contain whitespace",
+		},
+		"tab": {
+			subject: "orders\t.eu",
+			wantErr: "subject must not contain whitespace",
+		},
+		"leading dot": {
+			subject: ".orders",
+			wantErr: "subject must not contain empty tokens",
+		},
+		"trailing dot": {
+			subject: "orders.",
+			wantErr: "subject must not contain empty tokens",
+		},
+		"double dot": {
+			subject: "orders..eu",
+			wantErr: "subject must not contain empty tokens",
+		},
+		"full wildcard not last": {
+			subject: "orders.>.eu",
+			wantErr: "'>' wildcard must be the last token",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateSubject(tt.subject)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Fatalf("got error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSubjectMatches(t *testing.T) {
+	tests := []struct {
+		pattern string
+		subject string
+		want    bool
+	}{
+		{"orders.eu", "orders.eu", true},
+		{"orders.eu", "orders.us", false},
+		{"orders.eu", "orders", false},
+		{"orders", "orders.eu", false},
+
+		{"orders.*", "orders.eu", true},
+		{"orders.*", "orders", false},
+		{"orders.*", "orders.eu.new", false},
+		{"*.eu", "orders.eu", true},
+		{"*", "orders", true},
+		{"*", "orders.eu", false},
+
+		{"orders.>", "orders.eu", true},
+		{"orders.>", "orders.eu.new", true},
+		{"orders.>", "orders", false},
+		{">", "orders", true},
+		{">", "orders.eu.new", true},
+
+		// Wildcard subjects match when they are a subset of the pattern.
+		{"orders.>", "orders.*", true},
+		{"orders.>", "orders.*.new", true},
+		{"orders.>", "orders.>", true},
+		{"orders.*", "orders.*", true},
+		{"orders.*", "orders.>", false},
+		{"orders.eu", "orders.*", false},
+		{"*.*", "orders.>", false},
+		{"orders.*.new", "orders.>", false},
+
+		// Wildcard characters inside a token are literals.
+		{"orders.*", "orders.eu*", true},
+		{"orders.eu*", "orders.eu", false},
+		{"orders.eu*", "orders.eu*", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.subject, func(t *testing.T) {
+			if got := subjectMatches(tt.pattern, tt.subject); got != tt.want {
+				t.Errorf("subjectMatches(%q, %q) = %t, want %t", tt.pattern, tt.subject, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSubjectsOverlap(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"orders.eu", "orders.eu", true},
+		{"orders.eu", "orders.us", false},
+		{"orders", "orders.eu", false},
+
+		{"orders.*", "orders.eu", true},
+		{"orders.*", "*.eu", true},
+		{"orders.*", "*.*", true},
+		{"orders.*", "orders.eu.new", false},
+		{"orders.*", "payments.*", false},
+		{"*", "orders.eu", false},
+
+		{"orders.>", "orders.eu.new", true},
+		{"orders.>", "orders.*", true},
+		{"orders.>", "*.eu", true},
+		{"orders.>", "orders", false},
+		{"orders.>", "payments.>", false},
+		{">", "orders", true},
+		{">", "orders.eu.new", true},
+		{"*.>", "orders", false},
+
+		// Wildcard characters inside a token are literals.
+		{"orders.eu*", "orders.eu", false},
+		{"orders.eu*", "orders.*", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+" "+tt.b, func(t *testing.T) {
+			if got := subjectsOverlap(tt.a, tt.b); got != tt.want {
+				t.Errorf("subjectsOverlap(%q, %q) = %t, want %t", tt.a, tt.b, got, tt.want)
+			}
+			// Overlap is symmetric.
+			if got := subjectsOverlap(tt.b, tt.a); got != tt.want {
+				t.Errorf("subjectsOverlap(%q, %q) = %t, want %t", tt.b, tt.a, got, tt.want)
+			}
+		})
+	}
+}
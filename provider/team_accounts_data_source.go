@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TeamAccountsDataSource{}
+
+func NewTeamAccountsDataSource() datasource.DataSource {
+	return &TeamAccountsDataSource{}
+}
+
+// TeamAccountsDataSource defines the data source implementation.
+type TeamAccountsDataSource struct {
+	client *Client
+}
+
+// TeamAccountsDataSourceModel describes the data source data model.
+type TeamAccountsDataSourceModel struct {
+	TeamId   types.String          `tfsdk:"team_id"`
+	Name     types.String          `tfsdk:"name"`
+	Tag      types.String          `tfsdk:"tag"`
+	Accounts []AccountSummaryModel `tfsdk:"accounts"`
+}
+
+func (d *TeamAccountsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_accounts"
+}
+
+func (d *TeamAccountsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the accounts of every system owned by a team.",
+
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Team to list accounts of",
+				Required:            true,
+			},
+			"name":     nameFilterAttribute("accounts"),
+			"tag":      tagFilterAttribute("accounts"),
+			"accounts": accountSummariesAttribute(),
+		},
+	}
+}
+
+func (d *TeamAccountsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TeamAccountsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TeamAccountsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accounts, err := d.client.ListTeamAccounts(ctx, data.TeamId.ValueString(), ListFilter{
+		Name: data.Name.ValueString(),
+		Tag:  data.Tag.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list team accounts, got error: %s", err))
+		return
+	}
+
+	data.Accounts = accountSummariesFromAPI(accounts)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TeamNatsUsersDataSource{}
+
+func NewTeamNatsUsersDataSource() datasource.DataSource {
+	return &TeamNatsUsersDataSource{}
+}
+
+// TeamNatsUsersDataSource defines the data source implementation.
+type TeamNatsUsersDataSource struct {
+	client *Client
+}
+
+// TeamNatsUsersDataSourceModel describes the data source data model.
+type TeamNatsUsersDataSourceModel struct {
+	TeamId    types.String           `tfsdk:"team_id"`
+	AccountId types.String           `tfsdk:"account_id"`
+	Name      types.String           `tfsdk:"name"`
+	Tag       types.String           `tfsdk:"tag"`
+	Users     []NatsUserSummaryModel `tfsdk:"users"`
+}
+
+func (d *TeamNatsUsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_nats_users"
+}
+
+func (d *TeamNatsUsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the NATS users of every account owned by a team.",
+
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Team to list NATS users of",
+				Required:            true,
+			},
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "Only return users of this account",
+				Optional:            true,
+			},
+			"name":  nameFilterAttribute("users"),
+			"tag":   tagFilterAttribute("users"),
+			"users": natsUserSummariesAttribute(),
+		},
+	}
+}
+
+func (d *TeamNatsUsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TeamNatsUsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TeamNatsUsersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, err := d.client.ListTeamNatsUsers(ctx, data.TeamId.ValueString(), ListFilter{
+		Name:      data.Name.ValueString(),
+		Tag:       data.Tag.ValueString(),
+		AccountID: data.AccountId.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list team NATS users, got error: %s", err))
+		return
+	}
+
+	data.Users = natsUserSummariesFromAPI(users)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
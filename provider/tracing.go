@@ -0,0 +1,259 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// Tracing is off unless an exporter is selected with the tracing_exporter
+// attribute or SYNADIA_TRACING_EXPORTER. The OTLP exporter reads its endpoint
+// and headers from the standard OTEL_EXPORTER_OTLP_* variables and defaults
+// to a collector on localhost:4318; the file exporter appends one JSON
+// document per span for offline analysis.
+const (
+	envTracingExporter = "SYNADIA_TRACING_EXPORTER"
+	envTracingFile     = "SYNADIA_TRACING_FILE"
+
+	tracingExporterOTLP = "otlp"
+	tracingExporterFile = "file"
+
+	defaultTracingFile = "synadia-traces.json"
+
+	tracerName = "github.com/devonberta/terraform-provider-synadia-cloud/provider"
+
+	// tracingBatchTimeout is how long finished spans are buffered before
+	// they are exported, and so bounds what is lost if Terraform kills the
+	// provider before ShutdownTracing runs.
+	tracingBatchTimeout = time.Second
+)
+
+// Span attribute keys shared by operation and HTTP spans.
+const (
+	attrResourceType = attribute.Key("synadia.resource_type")
+	attrOperation    = attribute.Key("synadia.operation")
+	attrAccountID    = attribute.Key("synadia.account_id")
+	attrRetryCount   = attribute.Key("synadia.retry_count")
+)
+
+// tracing holds a tracer and the TracerProvider behind it. The zero value
+// traces nothing.
+type tracing struct {
+	tracer   trace.Tracer
+	provider *sdktrace.TracerProvider
+
+	// file is the trace file of the file exporter, closed on shutdown.
+	file *os.File
+}
+
+// tracers holds the tracing set up per exporter and trace file. Provider
+// instances configured alike share one TracerProvider, so it is built once
+// per process rather than on every Configure, and ShutdownTracing can flush
+// and close all of them on exit.
+var tracers = struct {
+	sync.Mutex
+	byKey map[string]*tracing
+}{byKey: map[string]*tracing{}}
+
+func (t *tracing) tracerOrNoop() trace.Tracer {
+	if t == nil || t.tracer == nil {
+		return noop.NewTracerProvider().Tracer(tracerName)
+	}
+	return t.tracer
+}
+
+// newTracing returns the tracing for exporter ("otlp" or "file"; empty
+// disables tracing), setting it up on first use in the process.
+func newTracing(ctx context.Context, exporter, file, version string) (*tracing, error) {
+	if exporter == "" {
+		return &tracing{}, nil
+	}
+
+	key := exporter
+	if exporter == tracingExporterFile {
+		key += ":" + file
+	}
+
+	tracers.Lock()
+	defer tracers.Unlock()
+
+	if t, ok := tracers.byKey[key]; ok {
+		return t, nil
+	}
+
+	t, err := startTracing(ctx, exporter, file, version)
+	if err != nil {
+		return nil, err
+	}
+	tracers.byKey[key] = t
+	return t, nil
+}
+
+// startTracing creates the exporter and a TracerProvider batching spans to it.
+func startTracing(ctx context.Context, exporter, file, version string) (*tracing, error) {
+	var spanExporter sdktrace.SpanExporter
+	var traceFile *os.File
+
+	switch exporter {
+	case tracingExporterOTLP:
+		e, err := otlptracehttp.New(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("creating OTLP exporter: %w", err)
+		}
+		spanExporter = e
+	case tracingExporterFile:
+		f, err := os.OpenFile(file, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+		if err != nil {
+			return nil, fmt.Errorf("opening trace file: %w", err)
+		}
+		e, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			f.Close()
+			return nil, fmt.Errorf("creating file exporter: %w", err)
+		}
+		spanExporter = e
+		traceFile = f
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %q, expected %q or %q", exporter, tracingExporterOTLP, tracingExporterFile)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(spanExporter, sdktrace.WithBatchTimeout(tracingBatchTimeout)),
+		sdktrace.WithResource(sdkresource.NewSchemaless(
+			attribute.String("service.name", "terraform-provider-synadia"),
+			attribute.String("service.version", version),
+		)),
+	)
+
+	return &tracing{tracer: tp.Tracer(tracerName), provider: tp, file: traceFile}, nil
+}
+
+// ShutdownTracing exports the spans still buffered, shuts down every
+// TracerProvider and closes the trace files. main calls it once the provider
+// server has stopped; ctx bounds the export.
+func ShutdownTracing(ctx context.Context) error {
+	tracers.Lock()
+	defer tracers.Unlock()
+
+	var errs []error
+	for key, t := range tracers.byKey {
+		errs = append(errs, t.provider.Shutdown(ctx))
+		if t.file != nil {
+			errs = append(errs, t.file.Close())
+		}
+		delete(tracers.byKey, key)
+	}
+	return errors.Join(errs...)
+}
+
+// operationSpan traces one CRUD operation of a resource.
+type operationSpan struct {
+	span trace.Span
+}
+
+// startOperation starts the span of operation ("create", "read", ...) on
+// resourceType. The returned context carries the span, so the HTTP calls made
+// with it become its children.
+func (c *Client) startOperation(ctx context.Context, resourceType, operation string) (context.Context, *operationSpan) {
+	ctx, span := c.tracing.tracerOrNoop().Start(ctx, resourceType+"."+operation,
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(
+			attrResourceType.String(resourceType),
+			attrOperation.String(operation),
+		),
+	)
+
+	return ctx, &operationSpan{span: span}
+}
+
+// setAccount records the account the operation works on.
+func (s *operationSpan) setAccount(accountID string) {
+	if accountID != "" {
+		s.span.SetAttributes(attrAccountID.String(accountID))
+	}
+}
+
+// end marks the span failed when diags has errors and ends it.
+func (s *operationSpan) end(diags diag.Diagnostics) {
+	if diags.HasError() {
+		s.span.SetStatus(codes.Error, diags.Errors()[0].Summary())
+	}
+	s.span.End()
+}
+
+// traceTransport starts a span for every control plane call. It sits above
+// the retry transport, which records its attempts on the span.
+type traceTransport struct {
+	base   http.RoundTripper
+	tracer trace.Tracer
+}
+
+func newTraceTransport(base http.RoundTripper, tracer trace.Tracer) *traceTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &traceTransport{base: base, tracer: tracer}
+}
+
+func (t *traceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	attrs := []attribute.KeyValue{
+		attribute.String("http.request.method", req.Method),
+		attribute.String("url.path", req.URL.Path),
+		attribute.String("server.address", req.URL.Host),
+		attrRetryCount.Int(0),
+	}
+	if accountID := accountIDFromPath(req.URL.Path); accountID != "" {
+		attrs = append(attrs, attrAccountID.String(accountID))
+	}
+
+	ctx, span := t.tracer.Start(req.Context(), "HTTP "+req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+	defer span.End()
+
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+	if resp.StatusCode >= 400 {
+		span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+	}
+
+	return resp, nil
+}
+
+// accountIDFromPath returns the account ID of control plane paths of the
+// form .../accounts/<id>/...
+func accountIDFromPath(p string) string {
+	parts := strings.Split(p, "/")
+	for i := 0; i < len(parts)-1; i++ {
+		if parts[i] == "accounts" {
+			return parts[i+1]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestNewTracing_SharedPerProcess(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() { _ = ShutdownTracing(ctx) })
+
+	dir := t.TempDir()
+	file := filepath.Join(dir, "traces.json")
+
+	a, err := newTracing(ctx, tracingExporterFile, file, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := newTracing(ctx, tracingExporterFile, file, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a != b {
+		t.Error("configuring the same exporter twice built a second TracerProvider")
+	}
+
+	other, err := newTracing(ctx, tracingExporterFile, filepath.Join(dir, "other.json"), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other == a {
+		t.Error("different trace files share a TracerProvider")
+	}
+
+	disabled, err := newTracing(ctx, "", file, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if disabled.tracer != nil {
+		t.Error("tracing is enabled without an exporter")
+	}
+}
+
+func TestShutdownTracing_FlushesAndClosesFile(t *testing.T) {
+	ctx := context.Background()
+	file := filepath.Join(t.TempDir(), "traces.json")
+
+	tr, err := newTracing(ctx, tracingExporterFile, file, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := NewClient("https://example.com", "token", nil)
+	c.tracing = tr
+	_, span := c.startOperation(ctx, "synadia_stream", "read")
+	span.setAccount("ACC")
+	span.end(diag.Diagnostics{})
+
+	if err := ShutdownTracing(ctx); err != nil {
+		t.Fatalf("ShutdownTracing: %s", err)
+	}
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"synadia_stream.read"`) {
+		t.Errorf("trace file does not contain the span:\n%s", data)
+	}
+
+	if _, err := tr.file.Write([]byte("x")); err == nil {
+		t.Error("trace file is still open after shutdown")
+	}
+
+	// The next Configure sets tracing up again.
+	again, err := newTracing(ctx, tracingExporterFile, file, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again == tr {
+		t.Error("newTracing returned a shut down TracerProvider")
+	}
+	if err := ShutdownTracing(ctx); err != nil {
+		t.Fatalf("ShutdownTracing: %s", err)
+	}
+}
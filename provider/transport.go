@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/http/httptrace"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Retry policy used when the provider block does not override it.
+const (
+	defaultMaxRetries = 4
+	retryBaseDelay    = 500 * time.Millisecond
+	retryMaxDelay     = 30 * time.Second
+)
+
+// idempotencyKeyHeader marks a POST or PATCH as safe to retry. The client does
+// not set it: the control plane does not document support for it, and a
+// retried create it does not deduplicate would fail with a conflict or create
+// a duplicate. Callers may set it for endpoints that do.
+const idempotencyKeyHeader = "Idempotency-Key"
+
+// retryTransport retries control plane requests that failed with a transient
+// error: connection failures, 429 Too Many Requests and 502/503/504.
+// Idempotent methods and requests carrying an Idempotency-Key are retried on
+// any of these; other POSTs and PATCHes only when the connection failed before
+// the request was sent, since the control plane may otherwise have applied
+// it. Waits grow exponentially with full jitter unless the server sends a
+// Retry-After header, which takes precedence up to retryMaxDelay.
+type retryTransport struct {
+	base       http.RoundTripper
+	maxRetries int
+}
+
+func newRetryTransport(base http.RoundTripper, maxRetries int) *retryTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &retryTransport{
+		base:       base,
+		maxRetries: maxRetries,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.Body != nil && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(ctx)
+			req.Body = body
+		}
+
+		var sent atomic.Bool
+		attemptReq := req.WithContext(httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+			WroteRequest: func(info httptrace.WroteRequestInfo) {
+				if info.Err == nil {
+					sent.Store(true)
+				}
+			},
+		}))
+
+		resp, err := t.base.RoundTrip(attemptReq)
+
+		if attempt >= t.maxRetries || !retryable(req, resp, err, sent.Load()) {
+			return resp, err
+		}
+
+		wait := retryWait(attempt, resp, err)
+
+		// Waiting past the deadline cannot succeed; report this attempt's
+		// outcome instead.
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+			return resp, err
+		}
+
+		fields := map[string]any{
+			"method":  req.Method,
+			"path":    req.URL.Path,
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status"] = resp.StatusCode
+
+			// Drain the body so the connection can be reused.
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		tflog.Warn(ctx, "retrying Synadia API request", fields)
+
+		// The call's span, if tracing is enabled, counts the retries.
+		trace.SpanFromContext(ctx).SetAttributes(attrRetryCount.Int(attempt + 1))
+
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// retryable reports whether the outcome of req may be retried. sent reports
+// whether the request was fully written to the connection.
+func retryable(req *http.Request, resp *http.Response, err error, sent bool) bool {
+	if req.Body != nil && req.GetBody == nil {
+		return false
+	}
+
+	if err != nil && req.Context().Err() != nil {
+		// The request context is done, retrying cannot succeed.
+		return false
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+	case http.MethodPost, http.MethodPatch:
+		if req.Header.Get(idempotencyKeyHeader) == "" {
+			return err != nil && !sent
+		}
+	default:
+		return false
+	}
+
+	if err != nil {
+		return true
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// retryWait returns how long to wait before retrying after attempt: the
+// response's Retry-After, capped at retryMaxDelay, or else the backoff.
+func retryWait(attempt int, resp *http.Response, err error) time.Duration {
+	if err == nil {
+		if d, ok := retryAfter(resp); ok {
+			return min(d, retryMaxDelay)
+		}
+	}
+	return backoff(attempt)
+}
+
+// backoff returns a random wait in [0, min(retryMaxDelay, retryBaseDelay*2^attempt)).
+func backoff(attempt int) time.Duration {
+	d := retryMaxDelay
+	if attempt < 16 {
+		d = min(retryBaseDelay<<attempt, retryMaxDelay)
+	}
+	return rand.N(d)
+}
+
+// retryAfter parses the Retry-After header, which holds either a number of
+// seconds or an HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		return max(time.Until(t), 0), true
+	}
+
+	return 0, false
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
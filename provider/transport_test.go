@@ -0,0 +1,271 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// statusServer answers each request with the next of statuses, repeating the
+// last one, and sets Retry-After to retryAfter when it is not empty. It
+// returns the server and the number of requests it received.
+func statusServer(t *testing.T, retryAfter string, statuses ...int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(calls.Add(1))
+		status := statuses[min(n, len(statuses))-1]
+		if retryAfter != "" && status != http.StatusOK {
+			w.Header().Set("Retry-After", retryAfter)
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, &calls
+}
+
+func TestRetryTransport(t *testing.T) {
+	tests := map[string]struct {
+		method         string
+		idempotencyKey bool
+		maxRetries     int
+		statuses       []int
+		wantStatus     int
+		wantCalls      int32
+	}{
+		"GET retried until it succeeds": {
+			method:     http.MethodGet,
+			maxRetries: 4,
+			statuses:   []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK},
+			wantStatus: http.StatusOK,
+			wantCalls:  3,
+		},
+		"GET gives up after max retries": {
+			method:     http.MethodGet,
+			maxRetries: 2,
+			statuses:   []int{http.StatusTooManyRequests},
+			wantStatus: http.StatusTooManyRequests,
+			wantCalls:  3,
+		},
+		"retries disabled": {
+			method:     http.MethodGet,
+			statuses:   []int{http.StatusServiceUnavailable},
+			wantStatus: http.StatusServiceUnavailable,
+			wantCalls:  1,
+		},
+		"DELETE retried": {
+			method:     http.MethodDelete,
+			maxRetries: 4,
+			statuses:   []int{http.StatusBadGateway, http.StatusNoContent},
+			wantStatus: http.StatusNoContent,
+			wantCalls:  2,
+		},
+		"server error not retried": {
+			method:     http.MethodGet,
+			maxRetries: 4,
+			statuses:   []int{http.StatusInternalServerError},
+			wantStatus: http.StatusInternalServerError,
+			wantCalls:  1,
+		},
+		"POST not retried": {
+			method:     http.MethodPost,
+			maxRetries: 4,
+			statuses:   []int{http.StatusServiceUnavailable, http.StatusOK},
+			wantStatus: http.StatusServiceUnavailable,
+			wantCalls:  1,
+		},
+		"POST with Idempotency-Key retried": {
+			method:         http.MethodPost,
+			idempotencyKey: true,
+			maxRetries:     4,
+			statuses:       []int{http.StatusServiceUnavailable, http.StatusCreated},
+			wantStatus:     http.StatusCreated,
+			wantCalls:      2,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv, calls := statusServer(t, "0", tt.statuses...)
+			client := &http.Client{Transport: newRetryTransport(srv.Client().Transport, tt.maxRetries)}
+
+			req, err := http.NewRequest(tt.method, srv.URL, strings.NewReader(`{"name":"orders"}`))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.idempotencyKey {
+				req.Header.Set(idempotencyKeyHeader, "key")
+			}
+
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("got status %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if got := calls.Load(); got != tt.wantCalls {
+				t.Errorf("got %d attempts, want %d", got, tt.wantCalls)
+			}
+		})
+	}
+}
+
+// failingTransport fails every request without sending it.
+type failingTransport struct {
+	calls atomic.Int32
+}
+
+func (t *failingTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	t.calls.Add(1)
+	return nil, errors.New("connection refused")
+}
+
+func TestRetryTransport_UnsentPOST(t *testing.T) {
+	base := &failingTransport{}
+	client := &http.Client{Transport: newRetryTransport(base, 1)}
+
+	_, err := client.Post("http://synadia.invalid/accounts", "application/json", strings.NewReader(`{}`))
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if got := base.calls.Load(); got != 2 {
+		t.Errorf("got %d attempts, want 2: a POST that was never sent is safe to retry", got)
+	}
+}
+
+func TestRetryTransport_SentPOST(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		// Drop the connection after the request was received.
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		conn.Close()
+	}))
+	t.Cleanup(srv.Close)
+
+	client := &http.Client{Transport: newRetryTransport(srv.Client().Transport, 4)}
+
+	_, err := client.Post(srv.URL, "application/json", strings.NewReader(`{}`))
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("got %d attempts, want 1: the control plane may have applied the POST", got)
+	}
+}
+
+func TestRetryTransport_Deadline(t *testing.T) {
+	srv, calls := statusServer(t, "60", http.StatusServiceUnavailable, http.StatusOK)
+	client := &http.Client{Transport: newRetryTransport(srv.Client().Transport, 4)}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("got status %d, want the last response, 503", resp.StatusCode)
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("got %d attempts, want 1", got)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("waited %s although Retry-After ends past the deadline", elapsed)
+	}
+}
+
+func TestRetryWait(t *testing.T) {
+	withRetryAfter := func(v string) *http.Response {
+		return &http.Response{Header: http.Header{"Retry-After": {v}}}
+	}
+
+	tests := map[string]struct {
+		resp    *http.Response
+		err     error
+		want    time.Duration
+		backoff bool
+	}{
+		"Retry-After seconds": {
+			resp: withRetryAfter("2"),
+			want: 2 * time.Second,
+		},
+		"Retry-After capped": {
+			resp: withRetryAfter("3600"),
+			want: retryMaxDelay,
+		},
+		"Retry-After date capped": {
+			resp: withRetryAfter(time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)),
+			want: retryMaxDelay,
+		},
+		"invalid Retry-After": {
+			resp:    withRetryAfter("soon"),
+			backoff: true,
+		},
+		"no Retry-After": {
+			resp:    &http.Response{Header: http.Header{}},
+			backoff: true,
+		},
+		"connection error": {
+			err:     errors.New("connection reset"),
+			backoff: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := retryWait(2, tt.resp, tt.err)
+			if tt.backoff {
+				if limit := 4 * retryBaseDelay; got < 0 || got >= limit {
+					t.Errorf("got %s, want a backoff in [0, %s)", got, limit)
+				}
+				return
+			}
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	for attempt, limit := range map[int]time.Duration{
+		0:  retryBaseDelay,
+		1:  2 * retryBaseDelay,
+		3:  8 * retryBaseDelay,
+		10: retryMaxDelay,
+		64: retryMaxDelay,
+	} {
+		for range 100 {
+			if got := backoff(attempt); got < 0 || got >= limit {
+				t.Fatalf("backoff(%d) = %s, want [0, %s)", attempt, got, limit)
+			}
+		}
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UsersDataSource{}
+
+func NewUsersDataSource() datasource.DataSource {
+	return &UsersDataSource{}
+}
+
+// UsersDataSource defines the data source implementation.
+type UsersDataSource struct {
+	client *Client
+}
+
+// UsersDataSourceModel describes the data source data model.
+type UsersDataSourceModel struct {
+	AccountId types.String           `tfsdk:"account_id"`
+	Name      types.String           `tfsdk:"name"`
+	Tag       types.String           `tfsdk:"tag"`
+	Users     []NatsUserSummaryModel `tfsdk:"users"`
+}
+
+// NatsUserSummaryModel describes a single NATS user entry.
+type NatsUserSummaryModel struct {
+	Id        types.String `tfsdk:"id"`
+	AccountId types.String `tfsdk:"account_id"`
+	Name      types.String `tfsdk:"name"`
+	PublicKey types.String `tfsdk:"public_key"`
+	Tags      []string     `tfsdk:"tags"`
+}
+
+func natsUserSummariesFromAPI(users []NatsUser) []NatsUserSummaryModel {
+	out := make([]NatsUserSummaryModel, 0, len(users))
+	for _, u := range users {
+		out = append(out, NatsUserSummaryModel{
+			Id:        types.StringValue(u.ID),
+			AccountId: types.StringValue(u.AccountID),
+			Name:      types.StringValue(u.Name),
+			PublicKey: types.StringValue(u.PublicKey),
+			Tags:      append([]string{}, u.Tags...),
+		})
+	}
+	return out
+}
+
+func natsUserSummariesAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: "Matching NATS users",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					MarkdownDescription: "NATS user identifier",
+					Computed:            true,
+				},
+				"account_id": schema.StringAttribute{
+					MarkdownDescription: "Account the user belongs to",
+					Computed:            true,
+				},
+				"name": schema.StringAttribute{
+					MarkdownDescription: "User name",
+					Computed:            true,
+				},
+				"public_key": schema.StringAttribute{
+					MarkdownDescription: "User public NKey",
+					Computed:            true,
+				},
+				"tags": schema.ListAttribute{
+					MarkdownDescription: "Tags of the user",
+					ElementType:         types.StringType,
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+func (d *UsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *UsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the NATS users of an account.",
+
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "Account to list NATS users in",
+				Required:            true,
+			},
+			"name":  nameFilterAttribute("users"),
+			"tag":   tagFilterAttribute("users"),
+			"users": natsUserSummariesAttribute(),
+		},
+	}
+}
+
+func (d *UsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UsersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, err := d.client.ListNatsUsers(ctx, data.AccountId.ValueString(), ListFilter{
+		Name: data.Name.ValueString(),
+		Tag:  data.Tag.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list NATS users, got error: %s", err))
+		return
+	}
+
+	data.Users = natsUserSummariesFromAPI(users)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
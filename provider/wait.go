@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Default timeouts for resources whose operations finish asynchronously in the
+// control plane. They can be overridden with a `timeouts` block.
+const (
+	defaultCreateTimeout = 10 * time.Minute
+	defaultUpdateTimeout = 10 * time.Minute
+	defaultDeleteTimeout = 10 * time.Minute
+)
+
+// Polling starts quickly and backs off so long waits do not hammer the API.
+const (
+	waitMinInterval = 1 * time.Second
+	waitMaxInterval = 15 * time.Second
+)
+
+// refreshFunc reports whether the awaited state has been reached together with
+// a short human readable status, e.g. "leader elected, 1/2 replicas current".
+type refreshFunc func(ctx context.Context) (done bool, status string, err error)
+
+// waitTimeoutError is returned by waitFor when the timeout expires first.
+type waitTimeoutError struct {
+	what       string
+	timeout    time.Duration
+	lastStatus string
+}
+
+func (e *waitTimeoutError) Error() string {
+	msg := fmt.Sprintf("timed out after %s waiting for %s", e.timeout, e.what)
+	if e.lastStatus != "" {
+		msg += fmt.Sprintf(" (last status: %s)", e.lastStatus)
+	}
+	return msg
+}
+
+// waitFor polls refresh until it reports done, refresh fails or timeout
+// expires. Every status change is logged so long waits show progress.
+func waitFor(ctx context.Context, timeout time.Duration, what string, refresh refreshFunc) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	interval := waitMinInterval
+	var last string
+
+	for {
+		done, status, err := refresh(ctx)
+		if err != nil {
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return &waitTimeoutError{what: what, timeout: timeout, lastStatus: last}
+			}
+			return err
+		}
+
+		if status != last {
+			tflog.Info(ctx, "waiting for "+what, map[string]any{
+				"status":  status,
+				"elapsed": time.Since(start).Round(time.Second).String(),
+			})
+			last = status
+		}
+
+		if done {
+			return nil
+		}
+
+		if err := sleep(ctx, interval); err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				return &waitTimeoutError{what: what, timeout: timeout, lastStatus: last}
+			}
+			return err
+		}
+		interval = min(interval*2, waitMaxInterval)
+	}
+}
+
+// addWaitError reports a failed wait for what. Timeouts get a dedicated
+// summary that points at the `timeouts` block.
+func addWaitError(diags *diag.Diagnostics, what string, err error) {
+	var timeoutErr *waitTimeoutError
+	if errors.As(err, &timeoutErr) {
+		diags.AddError(
+			"Operation Timed Out",
+			fmt.Sprintf("Unable to complete the operation: %s. It may still finish in the background; "+
+				"raise the matching value in the resource's `timeouts` block if it routinely takes longer.", err),
+		)
+		return
+	}
+
+	diags.AddError("Client Error", fmt.Sprintf("Unable to wait for %s, got error: %s", what, err))
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The control plane SDK exposes no revision or ETag for organizations,
+// projects and users, so their updates cannot be made conditional. Instead
+// they read the object right before writing it and refuse to write when it no
+// longer matches the state it was last read into, which narrows the window in
+// which a change made outside Terraform is overwritten to the two calls.
+
+// checkUnchanged compares remote, the managed attributes of the object as it
+// is now, with the values they had when the object was last read, and
+// returns an error listing the attributes that changed remotely.
+func checkUnchanged(d *schema.ResourceData, what string, remote map[string]interface{}) diag.Diagnostics {
+	var changes []string
+
+	for _, k := range slices.Sorted(maps.Keys(remote)) {
+		before, _ := d.GetChange(k)
+		b, a := formatValue(before), formatValue(remote[k])
+		if b != a {
+			changes = append(changes, fmt.Sprintf("  %s: %s -> %s", k, b, a))
+		}
+	}
+
+	if len(changes) == 0 {
+		return nil
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  "Resource Changed Outside Terraform",
+		Detail: fmt.Sprintf("The %s was changed outside of Terraform after it was last read, so the update was "+
+			"not applied to avoid overwriting that change.\n\nRemote changes:\n%s\n\nRun `terraform plan` to "+
+			"refresh the state and review the differences, then apply again.", what, strings.Join(changes, "\n")),
+	}}
+}
+
+// formatValue renders strings and string lists read from state or returned by
+// the SDK the same way, so the two can be compared.
+func formatValue(v interface{}) string {
+	switch v := v.(type) {
+	case []interface{}:
+		return formatValue(expandStringList(v))
+	case []string:
+		if len(v) == 0 {
+			return "(unset)"
+		}
+		return fmt.Sprintf("%q", v)
+	case string:
+		if v == "" {
+			return "(unset)"
+		}
+		return fmt.Sprintf("%q", v)
+	case nil:
+		return "(unset)"
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -121,26 +121,3 @@ func dataSourceUser() *schema.Resource {
 		},
 	}
 }
-
-func dataSourceStream() *schema.Resource {
-	return &schema.Resource{
-		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-			// Placeholder: implement once control-plane exposes streams.
-			d.SetId("stream-placeholder")
-			return nil
-		},
-		Schema: map[string]*schema.Schema{},
-	}
-}
-
-func dataSourceKVBucket() *schema.Resource {
-	return &schema.Resource{
-		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-			// Placeholder: implement once control-plane exposes KV bucket info.
-			d.SetId("kv-placeholder")
-			return nil
-		},
-		Schema: map[string]*schema.Schema{},
-	}
-}
-
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/synadia-io/control-plane-sdk-go/controlplane"
+)
+
+// Import IDs are slash separated. Clusters may also be imported by name and
+// users by email address; every other object is imported by its control
+// plane ID, prefixed with the IDs of its parents.
+//
+//	synadia_cluster          <cluster> (an ID or a name)
+//	synadia_organization     <organization_id>
+//	synadia_project          <organization_id>/<project_id>
+//	synadia_user             <organization_id>/<user> or <organization_id>/<project_id>/<user>
+//	                         (<user> is an ID or an email address)
+//	synadia_jwt_claim        <jwt_claim_id>
+//	synadia_permission       <permission_id>
+//	synadia_object_store     <cluster_id>/<object_store_id>
+//	synadia_cluster_gateway  <cluster_id>/<gateway_id>
+//	synadia_leafnode         <cluster_id>/<leafnode_id>
+//	synadia_service_export   <cluster_id>/<export_id>
+//	synadia_service_import   <cluster_id>/<import_id>
+
+// splitImportID splits id into exactly n non-empty parts or returns an error
+// naming the expected format.
+func splitImportID(id string, n int, format string) ([]string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != n {
+		return nil, fmt.Errorf("unexpected import ID %q, expected %s", id, format)
+	}
+	for _, p := range parts {
+		if p == "" {
+			return nil, fmt.Errorf("unexpected import ID %q, expected %s", id, format)
+		}
+	}
+	return parts, nil
+}
+
+// matchRef reports whether ref refers to an object by ID or by name.
+func matchRef(ref, id, name string) bool {
+	return ref == id || ref == name
+}
+
+func resourceClusterImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	client := m.(*controlplane.Client)
+
+	clusters, err := client.Clusters.ListClusters(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range clusters {
+		if matchRef(d.Id(), c.ID, c.Name) {
+			d.SetId(c.ID)
+			return []*schema.ResourceData{d}, nil
+		}
+	}
+	return nil, fmt.Errorf("cluster %q not found", d.Id())
+}
+
+func resourceProjectImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts, err := splitImportID(d.Id(), 2, "organization_id/project_id")
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(parts[1])
+	d.Set("organization_id", parts[0])
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceUserImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	client := m.(*controlplane.Client)
+
+	var orgID, projectID, ref string
+	switch parts := strings.Split(d.Id(), "/"); len(parts) {
+	case 2:
+		orgID, ref = parts[0], parts[1]
+	case 3:
+		orgID, projectID, ref = parts[0], parts[1], parts[2]
+	default:
+		return nil, fmt.Errorf("unexpected import ID %q, expected organization_id/user or organization_id/project_id/user", d.Id())
+	}
+
+	userID := ref
+	if strings.Contains(ref, "@") {
+		user, err := client.Users.GetUserByEmail(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+		userID = user.ID
+	}
+
+	d.SetId(userID)
+	d.Set("organization_id", orgID)
+	if projectID != "" {
+		d.Set("project_id", projectID)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceClusterScopedImport imports an object addressed by cluster_id and
+// its own ID. The control plane SDK has no list call for these collections,
+// so unlike clusters they cannot be imported by name.
+func resourceClusterScopedImport(kind string) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		parts, err := splitImportID(d.Id(), 2, "cluster_id/"+kind+"_id")
+		if err != nil {
+			return nil, err
+		}
+
+		d.SetId(parts[1])
+		d.Set("cluster_id", parts[0])
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+var (
+	resourceObjectStoreImport    = resourceClusterScopedImport("object_store")
+	resourceClusterGatewayImport = resourceClusterScopedImport("gateway")
+	resourceLeafnodeImport       = resourceClusterScopedImport("leafnode")
+	resourceServiceExportImport  = resourceClusterScopedImport("export")
+	resourceServiceImportImport  = resourceClusterScopedImport("import")
+)
@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package providerconfig holds the provider schema of the SDKv2 provider and
+// resolves its credentials. It does not depend on the control plane SDK, so
+// the schema can be checked against the framework provider's even where the
+// SDK is not available.
+package providerconfig
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+
+	"github.com/devonberta/terraform-provider-synadia-cloud/provider"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// transportAttributes are the provider attributes that shape the HTTP client.
+// The control plane SDK builds its own, so the SDKv2 resources cannot honor
+// them and Credentials rejects them instead of silently ignoring them.
+var transportAttributes = []string{
+	"max_retries",
+	"max_concurrent_requests",
+	"requests_per_second",
+	"ca_cert_file",
+	"client_cert_file",
+	"client_key_file",
+	"insecure_skip_verify",
+	"proxy_url",
+	"request_timeout",
+	"headers",
+}
+
+// Schema returns the provider schema. The muxed server requires it to match
+// the framework provider's attribute for attribute, descriptions included,
+// which is why descriptions are plain text here and there.
+func Schema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"endpoint": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "The Synadia control plane endpoint. May also be set with the `SYNADIA_API_ENDPOINT` " +
+				"environment variable or the `endpoint` key of the selected profile.",
+		},
+		"token": {
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+			Description: "API token for authenticating to Synadia control plane. May also be set with the " +
+				"`SYNADIA_API_TOKEN` environment variable or the `token` key of the selected profile.",
+		},
+		"api_endpoint": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Deprecated:    "Use endpoint instead.",
+			ConflictsWith: []string{"endpoint"},
+			Description:   "Deprecated alias of `endpoint`.",
+		},
+		"api_token": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Sensitive:     true,
+			Deprecated:    "Use token instead.",
+			ConflictsWith: []string{"token"},
+			Description:   "Deprecated alias of `token`.",
+		},
+		"profile": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "Profile to read from the credentials file (`SYNADIA_CREDENTIALS_FILE`, by default " +
+				"`synadia/credentials` in the user config directory such as `~/.config`). May also be set with the " +
+				"`SYNADIA_PROFILE` environment variable. Defaults to `default`.",
+		},
+		"max_retries": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Description: "Maximum number of times a request is retried after a rate limit, " +
+				"a 502/503/504 response or a connection error. Defaults to `4`; `0` disables retries.",
+		},
+		"max_concurrent_requests": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Description: "Maximum number of control plane requests in flight at once, shared by all " +
+				"resources and data sources of this provider instance. Defaults to `10`.",
+		},
+		"requests_per_second": {
+			Type:     schema.TypeFloat,
+			Optional: true,
+			Description: "Maximum sustained rate of control plane requests, shared by all resources and data " +
+				"sources of this provider instance. Unlimited when unset or `0`.",
+		},
+		"ca_cert_file": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "Path to a PEM bundle of CA certificates trusted in addition to the system roots, " +
+				"for control planes served with a private CA.",
+		},
+		"client_cert_file": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "Path to a PEM client certificate presented to the control plane (mTLS). " +
+				"Requires `client_key_file`.",
+		},
+		"client_key_file": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Path to the PEM private key for `client_cert_file`.",
+		},
+		"insecure_skip_verify": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Description: "Skip verification of the control plane's TLS certificate. Only use this in labs; " +
+				"it makes the connection vulnerable to interception.",
+		},
+		"proxy_url": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "HTTP(S) proxy for control plane requests, e.g. `http://proxy.internal:3128`. " +
+				"Defaults to the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables.",
+		},
+		"request_timeout": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "Maximum duration of a single control plane call including its retries, " +
+				"e.g. `30s` or `2m`. Unlimited by default.",
+		},
+		"headers": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Description: "Extra HTTP headers sent with every control plane request, e.g. for an " +
+				"authenticating gateway. Headers the provider sets itself are not overridden.",
+		},
+		"tracing_exporter": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "Export OpenTelemetry traces of resource operations and control plane calls: " +
+				"`otlp` sends them to the collector set by the `OTEL_EXPORTER_OTLP_*` environment variables " +
+				"(by default `localhost:4318`), `file` appends them to `tracing_file`. May also be set with the " +
+				"`SYNADIA_TRACING_EXPORTER` environment variable. Tracing is disabled by default.",
+		},
+		"tracing_file": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "File the `file` tracing exporter appends spans to as JSON. May also be set with the " +
+				"`SYNADIA_TRACING_FILE` environment variable. Defaults to `synadia-traces.json`.",
+		},
+	}
+}
+
+// Credentials resolves the endpoint and token the same way the framework
+// provider does, profiles and deprecated aliases included. Tracing settings
+// are ignored as the SDKv2 resources are not traced; transport settings are
+// reported as errors.
+func Credentials(d *schema.ResourceData) (provider.Credentials, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	for _, name := range transportAttributes {
+		if _, ok := d.GetOk(name); ok {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unsupported Provider Setting",
+				Detail: fmt.Sprintf("%s cannot be honored by the resources served by the SDKv2 provider, whose "+
+					"control plane client builds its own HTTP transport. Remove it, or build the provider "+
+					"without the sdkv2 build tag.", name),
+				AttributePath: cty.GetAttrPath(name),
+			})
+		}
+	}
+	if diags.HasError() {
+		return provider.Credentials{}, diags
+	}
+
+	creds, err := provider.LoadCredentials(
+		cmp.Or(d.Get("endpoint").(string), d.Get("api_endpoint").(string)),
+		cmp.Or(d.Get("token").(string), d.Get("api_token").(string)),
+		d.Get("profile").(string),
+	)
+	if errors.Is(err, provider.ErrMissingToken) {
+		return creds, diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Missing Synadia API Token",
+			Detail: "The provider cannot create the Synadia API client as no API token is configured. Set the token " +
+				"attribute, the SYNADIA_API_TOKEN environment variable or a token in the credentials file profile.",
+			AttributePath: cty.GetAttrPath("token"),
+		}}
+	}
+	if err != nil {
+		return creds, diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Unable to Load Synadia Profile",
+			Detail:        fmt.Sprintf("The provider cannot read the credentials file: %s", err),
+			AttributePath: cty.GetAttrPath("profile"),
+		}}
+	}
+	return creds, nil
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providerconfig
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/devonberta/terraform-provider-synadia-cloud/provider"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestCredentials(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials")
+	content := "[default]\ntoken = default-token\n\n[staging]\nendpoint = https://staging.example.com\ntoken = staging-token\n"
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("SYNADIA_CREDENTIALS_FILE", path)
+	t.Setenv("SYNADIA_API_ENDPOINT", "")
+	t.Setenv("SYNADIA_API_TOKEN", "")
+	t.Setenv("SYNADIA_PROFILE", "")
+
+	tests := map[string]struct {
+		config map[string]any
+		want   provider.Credentials
+	}{
+		"default profile": {
+			config: map[string]any{},
+			want:   provider.Credentials{Endpoint: provider.DefaultEndpoint, Token: "default-token"},
+		},
+		"profile only": {
+			config: map[string]any{"profile": "staging"},
+			want:   provider.Credentials{Endpoint: "https://staging.example.com", Token: "staging-token"},
+		},
+		"attributes": {
+			config: map[string]any{"endpoint": "https://attr.example.com", "token": "attr-token"},
+			want:   provider.Credentials{Endpoint: "https://attr.example.com", Token: "attr-token"},
+		},
+		"deprecated aliases": {
+			config: map[string]any{"api_endpoint": "https://attr.example.com", "api_token": "attr-token"},
+			want:   provider.Credentials{Endpoint: "https://attr.example.com", Token: "attr-token"},
+		},
+		"tracing settings are ignored": {
+			config: map[string]any{"token": "attr-token", "tracing_exporter": "file"},
+			want:   provider.Credentials{Endpoint: provider.DefaultEndpoint, Token: "attr-token"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, Schema(), tt.config)
+
+			got, diags := Credentials(d)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %+v", diags)
+			}
+			if got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCredentials_Errors(t *testing.T) {
+	t.Setenv("SYNADIA_CREDENTIALS_FILE", filepath.Join(t.TempDir(), "missing"))
+	t.Setenv("SYNADIA_API_ENDPOINT", "")
+	t.Setenv("SYNADIA_API_TOKEN", "")
+	t.Setenv("SYNADIA_PROFILE", "")
+
+	tests := map[string]struct {
+		config      map[string]any
+		wantSummary string
+	}{
+		"missing token": {
+			config:      map[string]any{},
+			wantSummary: "Missing Synadia API Token",
+		},
+		"missing profile": {
+			config:      map[string]any{"profile": "staging"},
+			wantSummary: "Unable to Load Synadia Profile",
+		},
+		"transport setting": {
+			config:      map[string]any{"token": "attr-token", "ca_cert_file": "ca.pem"},
+			wantSummary: "Unsupported Provider Setting",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, Schema(), tt.config)
+
+			_, diags := Credentials(d)
+			if len(diags) != 1 || diags[0].Summary != tt.wantSummary {
+				t.Fatalf("got %+v, want one %q error", diags, tt.wantSummary)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 
+	frameworkprovider "github.com/devonberta/terraform-provider-synadia-cloud/provider"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/synadia-io/control-plane-sdk-go/controlplane"
@@ -26,6 +27,11 @@ func resourceCluster() *schema.Resource {
 			}
 
 			d.SetId(cluster.ID)
+
+			if err := waitClusterReady(ctx, client, cluster.ID, d.Timeout(schema.TimeoutCreate)); err != nil {
+				return diag.Errorf("waiting for cluster %s to become ready: %s", cluster.ID, err)
+			}
+
 			return resourceClusterRead(ctx, d, m)
 		},
 		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -46,9 +52,16 @@ func resourceCluster() *schema.Resource {
 			if err := client.Clusters.DeleteCluster(ctx, d.Id()); err != nil {
 				return diag.FromErr(err)
 			}
+			if err := waitClusterDeleted(ctx, client, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+				return diag.Errorf("waiting for cluster %s to be deleted: %s", d.Id(), err)
+			}
 			d.SetId("")
 			return nil
 		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceClusterImport,
+		},
+		Timeouts: clusterTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -79,6 +92,9 @@ func resourceOrganization() *schema.Resource {
 		ReadContext:   resourceOrganizationRead,
 		UpdateContext: resourceOrganizationUpdate,
 		DeleteContext: resourceOrganizationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -125,12 +141,23 @@ func resourceOrganizationRead(ctx context.Context, d *schema.ResourceData, m int
 func resourceOrganizationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*controlplane.Client)
 
+	org, err := client.Organizations.GetOrganization(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if diags := checkUnchanged(d, "organization", map[string]interface{}{
+		"name":        org.Name,
+		"description": org.Description,
+	}); diags.HasError() {
+		return diags
+	}
+
 	req := &controlplane.UpdateOrganizationRequest{
 		Name:        d.Get("name").(string),
 		Description: d.Get("description").(string),
 	}
 
-	_, err := client.Organizations.UpdateOrganization(ctx, d.Id(), req)
+	_, err = client.Organizations.UpdateOrganization(ctx, d.Id(), req)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -158,6 +185,9 @@ func resourceProject() *schema.Resource {
 		ReadContext:   resourceProjectRead,
 		UpdateContext: resourceProjectUpdate,
 		DeleteContext: resourceProjectDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceProjectImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -211,14 +241,25 @@ func resourceProjectRead(ctx context.Context, d *schema.ResourceData, m interfac
 func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*controlplane.Client)
 
+	orgID := d.Get("organization_id").(string)
+
+	project, err := client.Projects.GetProject(ctx, orgID, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if diags := checkUnchanged(d, "project", map[string]interface{}{
+		"name":        project.Name,
+		"description": project.Description,
+	}); diags.HasError() {
+		return diags
+	}
+
 	req := &controlplane.UpdateProjectRequest{
 		Name:        d.Get("name").(string),
 		Description: d.Get("description").(string),
 	}
 
-	orgID := d.Get("organization_id").(string)
-
-	_, err := client.Projects.UpdateProject(ctx, orgID, d.Id(), req)
+	_, err = client.Projects.UpdateProject(ctx, orgID, d.Id(), req)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -249,6 +290,9 @@ func resourceUser() *schema.Resource {
 		ReadContext:   resourceUserRead,
 		UpdateContext: resourceUserUpdate,
 		DeleteContext: resourceUserDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUserImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"organization_id": {
 				Type:     schema.TypeString,
@@ -335,7 +379,19 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface
 	orgID := d.Get("organization_id").(string)
 	projectID, _ := d.Get("project_id").(string)
 
-	_, err := client.Users.UpdateUser(ctx, orgID, projectID, d.Id(), req)
+	user, err := client.Users.GetUser(ctx, orgID, projectID, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if diags := checkUnchanged(d, "user", map[string]interface{}{
+		"email": user.Email,
+		"name":  user.Name,
+		"roles": user.Roles,
+	}); diags.HasError() {
+		return diags
+	}
+
+	_, err = client.Users.UpdateUser(ctx, orgID, projectID, d.Id(), req)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -367,6 +423,9 @@ func resourceJWTClaim() *schema.Resource {
 		ReadContext:   resourceJWTClaimRead,
 		UpdateContext: resourceJWTClaimUpdate,
 		DeleteContext: resourceJWTClaimDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"user_id": {
 				Type:     schema.TypeString,
@@ -393,7 +452,12 @@ func resourceJWTClaimCreate(ctx context.Context, d *schema.ResourceData, m inter
 		Permissions: expandStringList(d.Get("permissions").([]interface{})),
 	}
 
+	unlock, err := lockAccountJWTs(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	claim, err := client.JWTClaims.CreateJWTClaim(ctx, req)
+	unlock()
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -424,7 +488,12 @@ func resourceJWTClaimUpdate(ctx context.Context, d *schema.ResourceData, m inter
 		Permissions: expandStringList(d.Get("permissions").([]interface{})),
 	}
 
-	_, err := client.JWTClaims.UpdateJWTClaim(ctx, d.Id(), req)
+	unlock, err := lockAccountJWTs(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_, err = client.JWTClaims.UpdateJWTClaim(ctx, d.Id(), req)
+	unlock()
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -435,7 +504,13 @@ func resourceJWTClaimUpdate(ctx context.Context, d *schema.ResourceData, m inter
 func resourceJWTClaimDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*controlplane.Client)
 
-	err := client.JWTClaims.DeleteJWTClaim(ctx, d.Id())
+	unlock, err := lockAccountJWTs(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
+
+	err = client.JWTClaims.DeleteJWTClaim(ctx, d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -453,6 +528,9 @@ func resourcePermission() *schema.Resource {
 		ReadContext:   resourcePermissionRead,
 		UpdateContext: resourcePermissionUpdate,
 		DeleteContext: resourcePermissionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"subject": {
 				Type:     schema.TypeString,
@@ -485,7 +563,12 @@ func resourcePermissionCreate(ctx context.Context, d *schema.ResourceData, m int
 		Allow:   d.Get("allow").(bool),
 	}
 
+	unlock, err := lockAccountJWTs(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	perm, err := client.Permissions.CreatePermission(ctx, req)
+	unlock()
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -518,144 +601,29 @@ func resourcePermissionUpdate(ctx context.Context, d *schema.ResourceData, m int
 		Allow:   d.Get("allow").(bool),
 	}
 
-	_, err := client.Permissions.UpdatePermission(ctx, d.Id(), req)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	return resourcePermissionRead(ctx, d, m)
-}
-
-func resourcePermissionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*controlplane.Client)
-
-	err := client.Permissions.DeletePermission(ctx, d.Id())
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	d.SetId("")
-	return nil
-}
-
-// --------------------------------------------------
-// synadia_stream resource
-
-func resourceStream() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceStreamCreate,
-		ReadContext:   resourceStreamRead,
-		UpdateContext: resourceStreamUpdate,
-		DeleteContext:   resourceStreamDelete,
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"cluster_id": {
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"subjects": {
-				Type:     schema.TypeList,
-				Required: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-			},
-			"max_msgs": {
-				Type:     schema.TypeInt,
-				Optional: true,
-			},
-			"max_bytes": {
-				Type:     schema.TypeInt,
-				Optional: true,
-			},
-			"max_age_seconds": {
-				Type:     schema.TypeInt,
-				Optional: true,
-			},
-		},
-	}
-}
-
-func resourceStreamCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*controlplane.Client)
-
-	req := &controlplane.CreateStreamRequest{
-		Name:     d.Get("name").(string),
-		Subjects: expandStringList(d.Get("subjects").([]interface{})),
-	}
-
-	if v, ok := d.GetOk("max_msgs"); ok {
-		req.MaxMsgs = int32(v.(int))
-	}
-	if v, ok := d.GetOk("max_bytes"); ok {
-		req.MaxBytes = int32(v.(int))
-	}
-	if v, ok := d.GetOk("max_age_seconds"); ok {
-		req.MaxAgeSeconds = int32(v.(int))
-	}
-
-	clusterID := d.Get("cluster_id").(string)
-
-	stream, err := client.Streams.CreateStream(ctx, clusterID, req)
+	unlock, err := lockAccountJWTs(ctx)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-
-	d.SetId(stream.ID)
-	return resourceStreamRead(ctx, d, m)
-}
-
-func resourceStreamRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*controlplane.Client)
-
-	clusterID := d.Get("cluster_id").(string)
-	stream, err := client.Streams.GetStream(ctx, clusterID, d.Id())
+	_, err = client.Permissions.UpdatePermission(ctx, d.Id(), req)
+	unlock()
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.Set("name", stream.Name)
-	d.Set("subjects", stream.Subjects)
-	d.Set("max_msgs", stream.MaxMsgs)
-	d.Set("max_bytes", stream.MaxBytes)
-	d.Set("max_age_seconds", stream.MaxAgeSeconds)
-	return nil
+	return resourcePermissionRead(ctx, d, m)
 }
 
-func resourceStreamUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourcePermissionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*controlplane.Client)
 
-	req := &controlplane.UpdateStreamRequest{
-		Name:     d.Get("name").(string),
-		Subjects: expandStringList(d.Get("subjects").([]interface{})),
-	}
-
-	if v, ok := d.GetOk("max_msgs"); ok {
-		req.MaxMsgs = int32(v.(int))
-	}
-	if v, ok := d.GetOk("max_bytes"); ok {
-		req.MaxBytes = int32(v.(int))
-	}
-	if v, ok := d.GetOk("max_age_seconds"); ok {
-		req.MaxAgeSeconds = int32(v.(int))
-	}
-
-	clusterID := d.Get("cluster_id").(string)
-
-	_, err := client.Streams.UpdateStream(ctx, clusterID, d.Id(), req)
+	unlock, err := lockAccountJWTs(ctx)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	defer unlock()
 
-	return resourceStreamRead(ctx, d, m)
-}
-
-func resourceStreamDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*controlplane.Client)
-
-	clusterID := d.Get("cluster_id").(string)
-	err := client.Streams.DeleteStream(ctx, clusterID, d.Id())
+	err = client.Permissions.DeletePermission(ctx, d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -666,6 +634,15 @@ func resourceStreamDelete(ctx context.Context, d *schema.ResourceData, m interfa
 
 // --------------------------------------------------
 
+// lockAccountJWTs serializes permission, JWT claim, service export and
+// service import changes, which re-sign the JWT of a user or account, with
+// every other JWT change in the process, the framework provider's included.
+// These resources are addressed by user or cluster and do not name the
+// account whose JWT is re-signed, so they take all accounts' locks at once.
+func lockAccountJWTs(ctx context.Context) (func(), error) {
+	return frameworkprovider.LockAllJWTs(ctx)
+}
+
 // Helper to convert []interface{} to []string
 func expandStringList(list []interface{}) []string {
 	res := make([]string, len(list))
@@ -675,102 +652,6 @@ func expandStringList(list []interface{}) []string {
 	return res
 }
 
-//-------------------------------------------------
-func resourceConsumer() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-			client := m.(*controlplane.Client)
-			req := &controlplane.CreateConsumerRequest{
-				Name:     d.Get("name").(string),
-				Durable:  d.Get("durable").(string),
-				StreamID: d.Get("stream_id").(string),
-			}
-			clusterID := d.Get("cluster_id").(string)
-			consumer, err := client.Consumers.CreateConsumer(ctx, clusterID, req)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-			d.SetId(consumer.ID)
-			return resourceConsumer().ReadContext(ctx, d, m)
-		},
-		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-			client := m.(*controlplane.Client)
-			clusterID := d.Get("cluster_id").(string)
-			consumer, err := client.Consumers.GetConsumer(ctx, clusterID, d.Id())
-			if err != nil {
-				return diag.FromErr(err)
-			}
-			d.Set("name", consumer.Name)
-			d.Set("durable", consumer.Durable)
-			d.Set("stream_id", consumer.StreamID)
-			return nil
-		},
-		DeleteContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-			client := m.(*controlplane.Client)
-			clusterID := d.Get("cluster_id").(string)
-			err := client.Consumers.DeleteConsumer(ctx, clusterID, d.Id())
-			if err != nil {
-				return diag.FromErr(err)
-			}
-			d.SetId("")
-			return nil
-		},
-		Schema: map[string]*schema.Schema{
-			"cluster_id": {Type: schema.TypeString, Required: true},
-			"stream_id":  {Type: schema.TypeString, Required: true},
-			"name":       {Type: schema.TypeString, Required: true},
-			"durable":    {Type: schema.TypeString, Required: true},
-		},
-	}
-}
-
-//-------------------------------------------------
-
-func resourceKVBucket() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-			client := m.(*controlplane.Client)
-			req := &controlplane.CreateKVBucketRequest{
-				Name:      d.Get("name").(string),
-				MaxValueSize: int64(d.Get("max_value_size").(int)),
-			}
-			clusterID := d.Get("cluster_id").(string)
-			bucket, err := client.KV.CreateKVBucket(ctx, clusterID, req)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-			d.SetId(bucket.ID)
-			return resourceKVBucket().ReadContext(ctx, d, m)
-		},
-		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-			client := m.(*controlplane.Client)
-			clusterID := d.Get("cluster_id").(string)
-			bucket, err := client.KV.GetKVBucket(ctx, clusterID, d.Id())
-			if err != nil {
-				return diag.FromErr(err)
-			}
-			d.Set("name", bucket.Name)
-			d.Set("max_value_size", int(bucket.MaxValueSize))
-			return nil
-		},
-		DeleteContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-			client := m.(*controlplane.Client)
-			clusterID := d.Get("cluster_id").(string)
-			err := client.KV.DeleteKVBucket(ctx, clusterID, d.Id())
-			if err != nil {
-				return diag.FromErr(err)
-			}
-			d.SetId("")
-			return nil
-		},
-		Schema: map[string]*schema.Schema{
-			"cluster_id":      {Type: schema.TypeString, Required: true},
-			"name":            {Type: schema.TypeString, Required: true},
-			"max_value_size":  {Type: schema.TypeInt, Optional: true, Default: 1024},
-		},
-	}
-}
-
 //-------------------------------------------------
 
 func resourceObjectStore() *schema.Resource {
@@ -808,6 +689,9 @@ func resourceObjectStore() *schema.Resource {
 			d.SetId("")
 			return nil
 		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceObjectStoreImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {Type: schema.TypeString, Required: true},
 			"name":       {Type: schema.TypeString, Required: true},
@@ -854,6 +738,9 @@ func resourceClusterGateway() *schema.Resource {
 			d.SetId("")
 			return nil
 		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceClusterGatewayImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id":        {Type: schema.TypeString, Required: true},
 			"remote_cluster_id": {Type: schema.TypeString, Required: true},
@@ -878,6 +765,11 @@ func resourceLeafnode() *schema.Resource {
 				return diag.FromErr(err)
 			}
 			d.SetId(ln.ID)
+
+			if err := waitLeafnodeProvisioned(ctx, client, clusterID, ln.ID, d.Timeout(schema.TimeoutCreate)); err != nil {
+				return diag.Errorf("waiting for leafnode %s to be provisioned: %s", ln.ID, err)
+			}
+
 			return resourceLeafnode().ReadContext(ctx, d, m)
 		},
 		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -898,9 +790,16 @@ func resourceLeafnode() *schema.Resource {
 			if err != nil {
 				return diag.FromErr(err)
 			}
+			if err := waitLeafnodeDeleted(ctx, client, clusterID, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+				return diag.Errorf("waiting for leafnode %s to be deleted: %s", d.Id(), err)
+			}
 			d.SetId("")
 			return nil
 		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceLeafnodeImport,
+		},
+		Timeouts: leafnodeTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:     schema.TypeString,
@@ -928,7 +827,12 @@ func resourceServiceExport() *schema.Resource {
 				Visibility: d.Get("visibility").(string),
 			}
 			clusterID := d.Get("cluster_id").(string)
+			unlock, err := lockAccountJWTs(ctx)
+			if err != nil {
+				return diag.FromErr(err)
+			}
 			exp, err := client.Services.CreateServiceExport(ctx, clusterID, req)
+			unlock()
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -950,13 +854,21 @@ func resourceServiceExport() *schema.Resource {
 		DeleteContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 			client := m.(*controlplane.Client)
 			clusterID := d.Get("cluster_id").(string)
-			err := client.Services.DeleteServiceExport(ctx, clusterID, d.Id())
+			unlock, err := lockAccountJWTs(ctx)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			defer unlock()
+			err = client.Services.DeleteServiceExport(ctx, clusterID, d.Id())
 			if err != nil {
 				return diag.FromErr(err)
 			}
 			d.SetId("")
 			return nil
 		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceServiceExportImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:     schema.TypeString,
@@ -989,7 +901,12 @@ func resourceServiceImport() *schema.Resource {
 				SubjectMapping: d.Get("subject_mapping").(string),
 			}
 			clusterID := d.Get("cluster_id").(string)
+			unlock, err := lockAccountJWTs(ctx)
+			if err != nil {
+				return diag.FromErr(err)
+			}
 			imp, err := client.Services.CreateServiceImport(ctx, clusterID, req)
+			unlock()
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -1011,13 +928,21 @@ func resourceServiceImport() *schema.Resource {
 		DeleteContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 			client := m.(*controlplane.Client)
 			clusterID := d.Get("cluster_id").(string)
-			err := client.Services.DeleteServiceImport(ctx, clusterID, d.Id())
+			unlock, err := lockAccountJWTs(ctx)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			defer unlock()
+			err = client.Services.DeleteServiceImport(ctx, clusterID, d.Id())
 			if err != nil {
 				return diag.FromErr(err)
 			}
 			d.SetId("")
 			return nil
 		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceServiceImportImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:     schema.TypeString,
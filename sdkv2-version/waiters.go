@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/synadia-io/control-plane-sdk-go/controlplane"
+)
+
+// Clusters and leafnodes are provisioned asynchronously: the create call
+// returns as soon as the request is accepted. The waiters below poll the
+// object's status until it settles, bounded by the resource's timeouts block.
+//
+// A leafnode is only waited for until the control plane has provisioned it.
+// A self-hosted leafnode connects once its creds are deployed, which usually
+// happens after the apply that created it.
+
+const (
+	defaultClusterTimeout  = 30 * time.Minute
+	defaultLeafnodeTimeout = 10 * time.Minute
+)
+
+// Status values reported by the control plane for clusters and leafnodes.
+const (
+	statusPending      = "pending"
+	statusProvisioning = "provisioning"
+	statusReady        = "ready"
+	statusConnecting   = "connecting"
+	statusConnected    = "connected"
+	statusDeleting     = "deleting"
+)
+
+func clusterTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(defaultClusterTimeout),
+		Delete: schema.DefaultTimeout(defaultClusterTimeout),
+	}
+}
+
+func leafnodeTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(defaultLeafnodeTimeout),
+		Delete: schema.DefaultTimeout(defaultLeafnodeTimeout),
+	}
+}
+
+func waitClusterReady(ctx context.Context, client *controlplane.Client, id string, timeout time.Duration) error {
+	conf := &retry.StateChangeConf{
+		Pending:    []string{statusPending, statusProvisioning},
+		Target:     []string{statusReady},
+		Refresh:    clusterStatus(ctx, client, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	_, err := conf.WaitForStateContext(ctx)
+	return err
+}
+
+func waitClusterDeleted(ctx context.Context, client *controlplane.Client, id string, timeout time.Duration) error {
+	conf := &retry.StateChangeConf{
+		Pending:    []string{statusPending, statusProvisioning, statusReady, statusDeleting},
+		Target:     []string{},
+		Refresh:    clusterStatus(ctx, client, id),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+	_, err := conf.WaitForStateContext(ctx)
+	return err
+}
+
+func clusterStatus(ctx context.Context, client *controlplane.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		cluster, err := client.Clusters.GetCluster(ctx, id)
+		if controlplane.IsNotFound(err) {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		tflog.Info(ctx, "waiting for cluster", map[string]any{"cluster_id": id, "status": cluster.Status})
+		return cluster, cluster.Status, nil
+	}
+}
+
+func waitLeafnodeProvisioned(ctx context.Context, client *controlplane.Client, clusterID, id string, timeout time.Duration) error {
+	conf := &retry.StateChangeConf{
+		Pending:    []string{statusPending, statusProvisioning},
+		Target:     []string{statusReady, statusConnecting, statusConnected},
+		Refresh:    leafnodeStatus(ctx, client, clusterID, id),
+		Timeout:    timeout,
+		Delay:      2 * time.Second,
+		MinTimeout: 2 * time.Second,
+	}
+	_, err := conf.WaitForStateContext(ctx)
+	return err
+}
+
+func waitLeafnodeDeleted(ctx context.Context, client *controlplane.Client, clusterID, id string, timeout time.Duration) error {
+	conf := &retry.StateChangeConf{
+		Pending:    []string{statusPending, statusProvisioning, statusReady, statusConnecting, statusConnected, statusDeleting},
+		Target:     []string{},
+		Refresh:    leafnodeStatus(ctx, client, clusterID, id),
+		Timeout:    timeout,
+		MinTimeout: 2 * time.Second,
+	}
+	_, err := conf.WaitForStateContext(ctx)
+	return err
+}
+
+func leafnodeStatus(ctx context.Context, client *controlplane.Client, clusterID, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		ln, err := client.Clusters.GetLeafnode(ctx, clusterID, id)
+		if controlplane.IsNotFound(err) {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		tflog.Info(ctx, "waiting for leafnode", map[string]any{"cluster_id": clusterID, "leafnode_id": id, "status": ln.Status})
+		return ln, ln.Status, nil
+	}
+}
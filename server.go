@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build !sdkv2
+
+package main
+
+import (
+	"context"
+
+	"github.com/devonberta/terraform-provider-synadia-cloud/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// newProviderServer serves the framework provider alone. The SDKv2 provider
+// depends on the control plane SDK and is only muxed in when built with the
+// sdkv2 tag; see server_sdkv2.go.
+func newProviderServer(_ context.Context, version string) (func() tfprotov6.ProviderServer, error) {
+	return providerserver.NewProtocol6(provider.New(version)()), nil
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build sdkv2
+
+package main
+
+import (
+	"context"
+
+	"github.com/devonberta/terraform-provider-synadia-cloud/provider"
+	sdkv2provider "github.com/devonberta/terraform-provider-synadia-cloud/sdkv2-version"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+)
+
+// newProviderServer combines the framework provider with the SDKv2 provider,
+// which is upgraded from protocol 5 to 6 so both can be served by one binary
+// while resources are migrated. Every resource and data source type must be
+// served by exactly one of them.
+func newProviderServer(ctx context.Context, version string) (func() tfprotov6.ProviderServer, error) {
+	upgradedSDKv2, err := tf5to6server.UpgradeServer(ctx, sdkv2provider.Provider().GRPCProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	servers := []func() tfprotov6.ProviderServer{
+		providerserver.NewProtocol6(provider.New(version)()),
+		func() tfprotov6.ProviderServer {
+			return upgradedSDKv2
+		},
+	}
+
+	muxServer, err := tf6muxserver.NewMuxServer(ctx, servers...)
+	if err != nil {
+		return nil, err
+	}
+
+	return muxServer.ProviderServer, nil
+}